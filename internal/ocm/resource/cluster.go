@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/openshift-online/ocm-common/pkg/cluster/validations"
 	diskValidator "github.com/openshift-online/ocm-common/pkg/machinepool/validations"
@@ -266,6 +269,63 @@ func (c *Cluster) ProcessEtcKMSKeyARN(kmsKeyARN *string, awsBuilder *cmv1.AWSBui
 	return nil
 }
 
+// ValidateSubnetsCount checks that the number of subnets of a BYO-VPC classic cluster matches its
+// availability zones: PrivateLink clusters need one private subnet per zone, and the rest of the
+// clusters need a public and a private subnet per zone.
+func ValidateSubnetsCount(multiAZ bool, isPrivateLink bool, subnetsCount int) error {
+	zones := 1
+	topology := "single AZ"
+	if multiAZ {
+		zones = 3
+		topology = "multi-AZ"
+	}
+	expected := zones * 2
+	if isPrivateLink {
+		expected = zones
+		topology += " PrivateLink"
+	}
+	if subnetsCount != expected {
+		return fmt.Errorf("The number of subnets for a %s cluster should be %d, instead received: %d",
+			topology, expected, subnetsCount)
+	}
+	return nil
+}
+
+// ValidateSubnetAZMapping checks that the mapping only uses subnets and zones of an HCP cluster,
+// and that the subnets are spread across the availability zones: a private cluster has a single
+// subnet in each zone, and a public cluster a public and a private subnet in each zone. The role
+// of the subnets isn't known, so for public clusters only the number of subnets in a zone is
+// checked.
+func ValidateSubnetAZMapping(mapping map[string]string, subnetIDs []string, availabilityZones []string,
+	isPrivate bool) error {
+	subnets := make([]string, 0, len(mapping))
+	for subnet := range mapping {
+		subnets = append(subnets, subnet)
+	}
+	sort.Strings(subnets)
+	mappedSubnets := map[string][]string{}
+	for _, subnet := range subnets {
+		az := mapping[subnet]
+		if !slices.Contains(subnetIDs, subnet) {
+			return fmt.Errorf("Subnet '%s' of the subnet to AZ mapping isn't one of the cluster subnets", subnet)
+		}
+		if !slices.Contains(availabilityZones, az) {
+			return fmt.Errorf("Availability zone '%s' of subnet '%s' isn't one of the cluster availability zones", az, subnet)
+		}
+		others := mappedSubnets[az]
+		if isPrivate && len(others) > 0 {
+			return fmt.Errorf("Subnets '%s' and '%s' are mapped to the same availability zone '%s', each subnet of a private cluster should be in a distinct availability zone",
+				others[0], subnet, az)
+		}
+		if len(others) > 1 {
+			return fmt.Errorf("Subnets '%s' and '%s' are mapped to the same availability zone '%s', a public cluster should have at most a public and a private subnet in each availability zone",
+				strings.Join(others, "', '"), subnet, az)
+		}
+		mappedSubnets[az] = append(others, subnet)
+	}
+	return nil
+}
+
 func (c *Cluster) SetAPIPrivacy(isPrivate bool, isPrivateLink bool, isSTS bool) error {
 	if isSTS && !isPrivate && isPrivateLink {
 		return errors.New("PrivateLink is only supported on private clusters")
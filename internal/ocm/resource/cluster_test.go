@@ -15,6 +15,62 @@ var _ = Describe("Cluster", func() {
 	BeforeEach(func() {
 		cluster = NewCluster()
 	})
+	Context("ValidateSubnetsCount", func() {
+		It("Single AZ with a public and a private subnet - success", func() {
+			Expect(ValidateSubnetsCount(false, false, 2)).To(Succeed())
+		})
+		It("Multi AZ with a public and a private subnet per zone - success", func() {
+			Expect(ValidateSubnetsCount(true, false, 6)).To(Succeed())
+		})
+		It("Single AZ PrivateLink with a private subnet - success", func() {
+			Expect(ValidateSubnetsCount(false, true, 1)).To(Succeed())
+		})
+		It("Multi AZ PrivateLink with a private subnet per zone - success", func() {
+			Expect(ValidateSubnetsCount(true, true, 3)).To(Succeed())
+		})
+		It("Multi AZ with three subnets - failure", func() {
+			err := ValidateSubnetsCount(true, false, 3)
+			Expect(err).To(MatchError("The number of subnets for a multi-AZ cluster should be 6, instead received: 3"))
+		})
+		It("Single AZ PrivateLink with two subnets - failure", func() {
+			err := ValidateSubnetsCount(false, true, 2)
+			Expect(err).To(MatchError("The number of subnets for a single AZ PrivateLink cluster should be 1, instead received: 2"))
+		})
+	})
+	Context("ValidateSubnetAZMapping", func() {
+		subnets := []string{"subnet-1", "subnet-2", "subnet-3"}
+		zones := []string{"us-east-1a", "us-east-1b"}
+		It("Subnets in distinct zones - success", func() {
+			mapping := map[string]string{"subnet-1": "us-east-1a", "subnet-2": "us-east-1b"}
+			Expect(ValidateSubnetAZMapping(mapping, subnets, zones, true)).To(Succeed())
+		})
+		It("Subnets of a private cluster in the same zone - failure", func() {
+			mapping := map[string]string{"subnet-1": "us-east-1a", "subnet-2": "us-east-1a"}
+			err := ValidateSubnetAZMapping(mapping, subnets, zones, true)
+			Expect(err).To(MatchError("Subnets 'subnet-1' and 'subnet-2' are mapped to the same availability zone 'us-east-1a', " +
+				"each subnet of a private cluster should be in a distinct availability zone"))
+		})
+		It("Public and private subnets of a public cluster in the same zone - success", func() {
+			mapping := map[string]string{"subnet-1": "us-east-1a", "subnet-2": "us-east-1a", "subnet-3": "us-east-1b"}
+			Expect(ValidateSubnetAZMapping(mapping, subnets, zones, false)).To(Succeed())
+		})
+		It("Three subnets of a public cluster in the same zone - failure", func() {
+			mapping := map[string]string{"subnet-1": "us-east-1a", "subnet-2": "us-east-1a", "subnet-3": "us-east-1a"}
+			err := ValidateSubnetAZMapping(mapping, subnets, zones, false)
+			Expect(err).To(MatchError("Subnets 'subnet-1', 'subnet-2' and 'subnet-3' are mapped to the same availability zone 'us-east-1a', " +
+				"a public cluster should have at most a public and a private subnet in each availability zone"))
+		})
+		It("Unknown subnet - failure", func() {
+			mapping := map[string]string{"subnet-4": "us-east-1a"}
+			err := ValidateSubnetAZMapping(mapping, subnets, zones, false)
+			Expect(err).To(MatchError("Subnet 'subnet-4' of the subnet to AZ mapping isn't one of the cluster subnets"))
+		})
+		It("Unknown availability zone - failure", func() {
+			mapping := map[string]string{"subnet-1": "us-east-1c"}
+			err := ValidateSubnetAZMapping(mapping, subnets, zones, false)
+			Expect(err).To(MatchError("Availability zone 'us-east-1c' of subnet 'subnet-1' isn't one of the cluster availability zones"))
+		})
+	})
 	Context("CreateNodes validation", func() {
 		It("Autoscaling disabled minReplicas set - failure", func() {
 			err := cluster.CreateNodes(rosaTypes.Classic, false, nil, pointer(int64(2)), nil, nil, nil, nil, false, nil, nil)
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	ocmlogging "github.com/openshift-online/ocm-sdk-go/logging"
+)
+
+// auditEvent is the line written to the audit log for each change made by the provider.
+type auditEvent struct {
+	Timestamp string `json:"timestamp"`
+	Resource  string `json:"resource"`
+	Action    string `json:"action"`
+	ClusterID string `json:"cluster_id"`
+}
+
+// auditActions are the actions written to the audit log, indexed by the HTTP method of the
+// requests that perform them. Requests with other methods don't change anything, so they aren't
+// audited.
+var auditActions = map[string]string{
+	http.MethodPost:   "create",
+	http.MethodPatch:  "update",
+	http.MethodPut:    "update",
+	http.MethodDelete: "delete",
+}
+
+// checkAuditLogFile checks that the audit log file can be opened for appending, creating it if
+// it doesn't exist, so that a wrong path is reported before any change is made.
+func checkAuditLogFile(path string) error {
+	file, err := openAuditLogFile(path)
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+func openAuditLogFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+}
+
+// auditTransportWrapper returns a transport wrapper that appends to the given file a JSON line
+// for each request to the OCM API that successfully creates, updates or deletes an object.
+// Failures to write the file are logged, but don't fail the request, as the change has already
+// been made.
+func auditTransportWrapper(path string, logger ocmlogging.Logger) func(http.RoundTripper) http.RoundTripper {
+	return func(wrapped http.RoundTripper) http.RoundTripper {
+		return &auditTransport{
+			wrapped: wrapped,
+			path:    path,
+			logger:  logger,
+		}
+	}
+}
+
+type auditTransport struct {
+	wrapped http.RoundTripper
+	path    string
+	logger  ocmlogging.Logger
+	lock    sync.Mutex
+}
+
+func (t *auditTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := t.wrapped.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	action, ok := auditActions[request.Method]
+	if !ok || response.StatusCode < 200 || response.StatusCode > 299 {
+		return response, nil
+	}
+	segments, ok := auditPathSegments(request.URL.Path)
+	if !ok {
+		return response, nil
+	}
+	event := auditEvent{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Action:    action,
+	}
+
+	// The paths look like `clusters/123/machine_pools/mp-1`, so the resource is the last
+	// collection of the path and the cluster is the identifier that follows `clusters`:
+	if len(segments)%2 == 0 {
+		event.Resource = segments[len(segments)-2]
+	} else {
+		event.Resource = segments[len(segments)-1]
+	}
+	if segments[0] == "clusters" {
+		if len(segments) > 1 {
+			event.ClusterID = segments[1]
+		} else {
+			// The identifier of a new cluster is only in the response:
+			event.ClusterID, err = t.readClusterID(response)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	t.write(request, event)
+	return response, nil
+}
+
+// auditPathSegments returns the segments of the path of a request to the OCM API that follow
+// the service name and version, for example `clusters`, `123` and `machine_pools` for
+// `/api/clusters_mgmt/v1/clusters/123/machine_pools`.
+func auditPathSegments(path string) ([]string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 4 || segments[0] != "api" {
+		return nil, false
+	}
+	return segments[3:], true
+}
+
+// readClusterID reads the identifier of the cluster from the body of the response, and
+// replaces the body so that it can still be read by the SDK.
+func (t *auditTransport) readClusterID(response *http.Response) (string, error) {
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	var cluster struct {
+		ID string `json:"id"`
+	}
+	// A body that isn't a cluster only leaves the identifier empty:
+	_ = json.Unmarshal(body, &cluster)
+	return cluster.ID, nil
+}
+
+func (t *auditTransport) write(request *http.Request, event auditEvent) {
+	line, err := json.Marshal(event)
+	if err != nil {
+		t.logger.Warn(request.Context(), "Can't marshal audit event: %v", err)
+		return
+	}
+	line = append(line, '\n')
+
+	// Terraform applies resources in parallel, so the lines have to be written one at a time:
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	file, err := openAuditLogFile(t.path)
+	if err != nil {
+		t.logger.Warn(request.Context(), "Can't open audit log file '%s': %v", t.path, err)
+		return
+	}
+	defer file.Close()
+	_, err = file.Write(line)
+	if err != nil {
+		t.logger.Warn(request.Context(), "Can't write audit log file '%s': %v", t.path, err)
+	}
+}
@@ -23,8 +23,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
+// defaultPageSize is the number of cloud providers requested in each page when the `page_size`
+// attribute isn't set.
+const defaultPageSize = 100
+
 type CloudProvidersDataSource struct {
 	collection *cmv1.CloudProvidersClient
 }
@@ -52,6 +58,7 @@ func (s *CloudProvidersDataSource) Schema(ctx context.Context, req datasource.Sc
 				Description: "Order criteria.",
 				Optional:    true,
 			},
+			"page_size": common.PageSizeAttribute(defaultPageSize),
 			"item": schema.SingleNestedAttribute{
 				Description: "Content of the list when there is exactly one item.",
 				Attributes:  s.itemAttributes(),
@@ -114,7 +121,7 @@ func (s *CloudProvidersDataSource) Read(ctx context.Context, req datasource.Read
 
 	// Fetch the complete list of cloud providers:
 	var listItems []*cmv1.CloudProvider
-	listSize := 100
+	listSize := common.PageSize(state.PageSize, defaultPageSize)
 	listPage := 1
 	listRequest := s.collection.List().Size(listSize)
 	if !state.Search.IsUnknown() && !state.Search.IsNull() {
@@ -155,11 +162,7 @@ func (s *CloudProvidersDataSource) Read(ctx context.Context, req datasource.Read
 			DisplayName: listItem.DisplayName(),
 		}
 	}
-	if len(state.Items) == 1 {
-		state.Item = state.Items[0]
-	} else {
-		state.Item = nil
-	}
+	state.Item = common.PopulateSingleItem(state.Items)
 
 	// Save the state:
 	diags = resp.State.Set(ctx, state)
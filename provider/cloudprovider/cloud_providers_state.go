@@ -19,8 +19,9 @@ package cloudprovider
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type CloudProvidersState struct {
-	Search types.String          `tfsdk:"search"`
-	Order  types.String          `tfsdk:"order"`
-	Item   *CloudProviderState   `tfsdk:"item"`
-	Items  []*CloudProviderState `tfsdk:"items"`
+	Search   types.String          `tfsdk:"search"`
+	Order    types.String          `tfsdk:"order"`
+	PageSize types.Int64           `tfsdk:"page_size"`
+	Item     *CloudProviderState   `tfsdk:"item"`
+	Items    []*CloudProviderState `tfsdk:"items"`
 }
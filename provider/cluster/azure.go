@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+)
+
+const azureCloudProvider = "azure"
+
+type Azure struct {
+	TenantID                               types.String `tfsdk:"tenant_id"`
+	SubscriptionID                         types.String `tfsdk:"subscription_id"`
+	ResourceGroupName                      types.String `tfsdk:"resource_group_name"`
+	ResourceName                           types.String `tfsdk:"resource_name"`
+	ManagedResourceGroupName               types.String `tfsdk:"managed_resource_group_name"`
+	SubnetResourceID                       types.String `tfsdk:"subnet_resource_id"`
+	NetworkSecurityGroupResourceID         types.String `tfsdk:"network_security_group_resource_id"`
+	ControlPlaneOperatorsManagedIdentities types.Map    `tfsdk:"control_plane_operators_managed_identities"`
+	DataPlaneOperatorsManagedIdentities    types.Map    `tfsdk:"data_plane_operators_managed_identities"`
+	ServiceManagedIdentity                 types.String `tfsdk:"service_managed_identity"`
+}
+
+func azureResource() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"tenant_id": schema.StringAttribute{
+			Description: "Identifier of the Microsoft Entra tenant of the subscription.",
+			Required:    true,
+		},
+		"subscription_id": schema.StringAttribute{
+			Description: "Identifier of the Azure subscription where the cluster is created.",
+			Required:    true,
+		},
+		"resource_group_name": schema.StringAttribute{
+			Description: "Name of the Azure resource group of the cluster resource.",
+			Required:    true,
+		},
+		"resource_name": schema.StringAttribute{
+			Description: "Name of the Azure resource of the cluster.",
+			Required:    true,
+		},
+		"managed_resource_group_name": schema.StringAttribute{
+			Description: "Name of the Azure resource group where the resources managed by the " +
+				"service are created. If not supplied, it will be auto generated.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"subnet_resource_id": schema.StringAttribute{
+			Description: "Resource ID of the Azure subnet where the nodes are created.",
+			Required:    true,
+		},
+		"network_security_group_resource_id": schema.StringAttribute{
+			Description: "Resource ID of the Azure network security group attached to the subnet.",
+			Required:    true,
+		},
+		"control_plane_operators_managed_identities": schema.MapAttribute{
+			Description: "Resource IDs of the user assigned managed identities used by the control " +
+				"plane operators, indexed by operator name.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"data_plane_operators_managed_identities": schema.MapAttribute{
+			Description: "Resource IDs of the user assigned managed identities used by the data " +
+				"plane operators, indexed by operator name.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"service_managed_identity": schema.StringAttribute{
+			Description: "Resource ID of the user assigned managed identity used by the service.",
+			Optional:    true,
+		},
+	}
+}
+
+// azureValidator checks that the Azure settings are only given for Azure clusters.
+func azureValidator() validator.Object {
+	return attrvalidators.NewObjectValidator("azure validator", func(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+		if req.ConfigValue.IsNull() {
+			return
+		}
+		cloudProvider := types.StringNull()
+		diags := req.Config.GetAttribute(ctx, path.Root("cloud_provider"), &cloudProvider)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if cloudProvider.IsUnknown() {
+			return
+		}
+		if cloudProvider.ValueString() != azureCloudProvider {
+			resp.Diagnostics.AddAttributeError(req.Path, "unexpected Azure attribute",
+				fmt.Sprintf("'azure' can only be set when 'cloud_provider' is '%s'", azureCloudProvider),
+			)
+		}
+	})
+}
+
+// buildAzure returns the Azure settings of the cluster.
+func buildAzure(ctx context.Context, state *Azure) (*cmv1.AzureBuilder, error) {
+	azure := cmv1.NewAzure().
+		TenantID(state.TenantID.ValueString()).
+		SubscriptionID(state.SubscriptionID.ValueString()).
+		ResourceGroupName(state.ResourceGroupName.ValueString()).
+		ResourceName(state.ResourceName.ValueString()).
+		SubnetResourceID(state.SubnetResourceID.ValueString()).
+		NetworkSecurityGroupResourceID(state.NetworkSecurityGroupResourceID.ValueString())
+	if common.HasValue(state.ManagedResourceGroupName) {
+		azure.ManagedResourceGroupName(state.ManagedResourceGroupName.ValueString())
+	}
+
+	managedIdentities := cmv1.NewAzureOperatorsAuthenticationManagedIdentities()
+	controlPlane, err := common.OptionalMap(ctx, state.ControlPlaneOperatorsManagedIdentities)
+	if err != nil {
+		return nil, err
+	}
+	if len(controlPlane) > 0 {
+		identities := map[string]*cmv1.AzureControlPlaneManagedIdentityBuilder{}
+		for name, resourceID := range controlPlane {
+			identities[name] = cmv1.NewAzureControlPlaneManagedIdentity().ResourceID(resourceID)
+		}
+		managedIdentities.ControlPlaneOperatorsManagedIdentities(identities)
+	}
+	dataPlane, err := common.OptionalMap(ctx, state.DataPlaneOperatorsManagedIdentities)
+	if err != nil {
+		return nil, err
+	}
+	if len(dataPlane) > 0 {
+		identities := map[string]*cmv1.AzureDataPlaneManagedIdentityBuilder{}
+		for name, resourceID := range dataPlane {
+			identities[name] = cmv1.NewAzureDataPlaneManagedIdentity().ResourceID(resourceID)
+		}
+		managedIdentities.DataPlaneOperatorsManagedIdentities(identities)
+	}
+	if common.HasValue(state.ServiceManagedIdentity) {
+		managedIdentities.ServiceManagedIdentity(
+			cmv1.NewAzureServiceManagedIdentity().ResourceID(state.ServiceManagedIdentity.ValueString()))
+	}
+	if !managedIdentities.Empty() {
+		azure.OperatorsAuthentication(
+			cmv1.NewAzureOperatorsAuthentication().ManagedIdentities(managedIdentities))
+	}
+	return azure, nil
+}
+
+// populateAzureState copies the Azure settings from the API object to the Terraform state. The
+// result is nil if the cluster doesn't run in Azure.
+func populateAzureState(object *cmv1.Cluster) (*Azure, error) {
+	azure, ok := object.GetAzure()
+	if !ok {
+		return nil, nil
+	}
+	state := &Azure{
+		TenantID:                               types.StringValue(azure.TenantID()),
+		SubscriptionID:                         types.StringValue(azure.SubscriptionID()),
+		ResourceGroupName:                      types.StringValue(azure.ResourceGroupName()),
+		ResourceName:                           types.StringValue(azure.ResourceName()),
+		ManagedResourceGroupName:               types.StringValue(azure.ManagedResourceGroupName()),
+		SubnetResourceID:                       types.StringValue(azure.SubnetResourceID()),
+		NetworkSecurityGroupResourceID:         types.StringValue(azure.NetworkSecurityGroupResourceID()),
+		ControlPlaneOperatorsManagedIdentities: types.MapNull(types.StringType),
+		DataPlaneOperatorsManagedIdentities:    types.MapNull(types.StringType),
+		ServiceManagedIdentity:                 types.StringNull(),
+	}
+
+	managedIdentities := azure.OperatorsAuthentication().ManagedIdentities()
+	if identities := managedIdentities.ControlPlaneOperatorsManagedIdentities(); len(identities) > 0 {
+		resourceIDs := map[string]string{}
+		for name, identity := range identities {
+			resourceIDs[name] = identity.ResourceID()
+		}
+		value, err := common.ConvertStringMapToMapType(resourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		state.ControlPlaneOperatorsManagedIdentities = value
+	}
+	if identities := managedIdentities.DataPlaneOperatorsManagedIdentities(); len(identities) > 0 {
+		resourceIDs := map[string]string{}
+		for name, identity := range identities {
+			resourceIDs[name] = identity.ResourceID()
+		}
+		value, err := common.ConvertStringMapToMapType(resourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		state.DataPlaneOperatorsManagedIdentities = value
+	}
+	if resourceID, ok := managedIdentities.ServiceManagedIdentity().GetResourceID(); ok {
+		state.ServiceManagedIdentity = types.StringValue(resourceID)
+	}
+	return state, nil
+}
@@ -19,7 +19,10 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/proxy"
@@ -29,23 +32,38 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
+	commonutils "github.com/openshift-online/ocm-common/pkg/utils"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/openshift-online/ocm-sdk-go/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	rosaTypes "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common/types"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/defaultingress"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/identityprovider"
 )
 
+var validAPIListeningMethods = []string{string(cmv1.ListeningMethodExternal), string(cmv1.ListeningMethodInternal)}
+
 type ClusterResource struct {
 	collection *cmv1.ClustersClient
 }
 
 var _ resource.ResourceWithConfigure = &ClusterResource{}
 var _ resource.ResourceWithImportState = &ClusterResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterResource{}
 
 func New() resource.Resource {
 	return &ClusterResource{}
@@ -74,11 +92,20 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:    true,
 			},
 			"domain_prefix": schema.StringAttribute{
-				Description: "The domain prefix is optionally assigned by the user." +
-					"It will appear in the Cluster's domain when the cluster is provisioned. " +
-					"If not supplied, it will be auto generated",
+				Description: fmt.Sprintf("The domain prefix is optionally assigned by the user. "+
+					"It will appear in the Cluster's domain when the cluster is provisioned. "+
+					"If not supplied, it will be auto generated. It must be a valid DNS label "+
+					"of at most %d characters. Changing it forces the replacement of the cluster.",
+					rosa.MaxClusterDomainPrefixLength),
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					domainPrefixValidator(),
+				},
 			},
 			"cloud_provider": schema.StringAttribute{
 				Description: "Cloud provider identifier, for example 'aws'.",
@@ -97,6 +124,92 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"fips": schema.BoolAttribute{
+				Description: "Create a cluster that uses FIPS Validated / Modules in Process cryptographic libraries. " +
+					"Changing it forces the replacement of the cluster.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"create_admin_user": schema.BoolAttribute{
+				Description: "Indicates if a cluster admin user is created, available in `admin_credentials`. " +
+					"Unless `admin_username` and `admin_password` are set the username is `cluster-admin` " +
+					"and the password is generated. Changing it forces the replacement of the cluster.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin_username": schema.StringAttribute{
+				Description: "Username of the cluster admin user. Setting it implies `create_admin_user`. " +
+					"Changing it forces the replacement of the cluster.",
+				Optional:   true,
+				Validators: identityprovider.HTPasswdUsernameValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin_password": schema.StringAttribute{
+				Description: "Password of the cluster admin user. Setting it implies `create_admin_user`. " +
+					"Changing it forces the replacement of the cluster.",
+				Optional:   true,
+				Sensitive:  true,
+				Validators: identityprovider.HTPasswdPasswordValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin_credentials": schema.SingleNestedAttribute{
+				Description: "Credentials of the cluster admin user created when `create_admin_user` is 'true'.",
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "Username of the cluster admin user.",
+						Computed:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Password of the cluster admin user.",
+						Computed:    true,
+						Sensitive:   true,
+					},
+				},
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disable_workload_monitoring": schema.BoolAttribute{
+				Description: "Disables the monitoring of user defined projects. " +
+					"Default value is 'false'.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"etcd_encryption": schema.BoolAttribute{
+				Description: "Encrypt etcd data with the KMS key given in 'etcd_encryption_kms_key_arn'. " +
+					"Changing it forces the replacement of the cluster.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"etcd_encryption_kms_key_arn": schema.StringAttribute{
+				Description: "ARN of the customer KMS key used to encrypt etcd data. It is required when " +
+					"'etcd_encryption' is 'true' and not allowed otherwise. " +
+					"Changing it forces the replacement of the cluster.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{etcdEncryptionKMSKeyARNValidator()},
+			},
 			"properties": schema.MapAttribute{
 				Description: "User defined properties.",
 				ElementType: types.StringType,
@@ -111,10 +224,57 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "URL of the console.",
 				Computed:    true,
 			},
+			"oauth_url": schema.StringAttribute{
+				Description: "URL of the OAuth server. Empty until the cluster is ready.",
+				Computed:    true,
+			},
 			"compute_nodes": schema.Int64Attribute{
-				Description: "Number of compute nodes of the cluster.",
+				Description: "Number of compute nodes of the cluster. Must be a multiple of 3 " +
+					"for multi zone clusters.",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.Int64{computeNodesValidator()},
+			},
+			"autoscaling_enabled": schema.BoolAttribute{
+				Description: "Enables autoscaling of the default compute pool between `min_replicas` " +
+					"and `max_replicas` when the cluster is created. Can't be set together with " +
+					"`compute_nodes`. Changing it forces the replacement of the cluster.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_replicas": schema.Int64Attribute{
+				Description: "Minimum number of compute nodes of the default compute pool when " +
+					"`autoscaling_enabled` is 'true'. Must be a multiple of 3 for multi zone clusters. " +
+					"Changing it forces the replacement of the cluster.",
+				Optional:   true,
+				Validators: []validator.Int64{autoscalingReplicasValidator()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_replicas": schema.Int64Attribute{
+				Description: "Maximum number of compute nodes of the default compute pool when " +
+					"`autoscaling_enabled` is 'true'. Must be a multiple of 3 for multi zone clusters " +
+					"and at least `min_replicas`. Changing it forces the replacement of the cluster.",
+				Optional:   true,
+				Validators: []validator.Int64{autoscalingReplicasValidator()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"default_machine_pool_labels": schema.MapAttribute{
+				Description: "Labels applied to the nodes of the default compute pool " +
+					"when the cluster is created. Keys must be valid Kubernetes label keys.",
+				ElementType: types.StringType,
 				Optional:    true,
-				Computed:    true,
+				Validators: []validator.Map{
+					labelKeysValidator(),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
 			},
 			"compute_machine_type": schema.StringAttribute{
 				Description: "Identifier of the machine type used by the compute nodes, " +
@@ -145,6 +305,28 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"gcp_project_id": schema.StringAttribute{
+				Description: "Identifier of the GCP project. Can only be set for GCP clusters, " +
+					"and takes precedence over the project of 'gcp_service_account_key'. " +
+					"Changing it forces the replacement of the cluster.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{gcpOnlyValidator()},
+			},
+			"gcp_service_account_key": schema.StringAttribute{
+				Description: "Content of the JSON key file of the GCP service account used to " +
+					"create the cluster. Can only be set for GCP clusters.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					gcpOnlyValidator(),
+					gcpServiceAccountKeyValidator(),
+				},
+			},
 			"aws_subnet_ids": schema.ListAttribute{
 				Description: "AWS subnet IDs.",
 				ElementType: types.StringType,
@@ -174,6 +356,21 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					listplanmodifier.RequiresReplace(),
 				},
 			},
+			"api_listening": schema.StringAttribute{
+				Description: fmt.Sprintf("Visibility of the API server. Options are %s. "+
+					"Must be 'internal' when 'aws_private_link' is true.",
+					strings.Join(validAPIListeningMethods, ",")),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					attrvalidators.EnumValueValidator(validAPIListeningMethods),
+					apiListeningValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"aws_private_link": schema.BoolAttribute{
 				Description: "Provides private connectivity between VPCs, AWS services, and your on-premises networks, without exposing your traffic to the public internet.",
 				Optional:    true,
@@ -184,7 +381,8 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"availability_zones": schema.ListAttribute{
-				Description: "Availability zones.",
+				Description: "Availability zones. Single zone clusters require one zone and " +
+					"multi zone clusters require three.",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
@@ -192,26 +390,56 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					listplanmodifier.UseStateForUnknown(),
 					listplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.List{availabilityZonesValidator()},
 			},
 			"machine_cidr": schema.StringAttribute{
-				Description: "Block of IP addresses for nodes.",
+				Description: "Block of IP addresses for nodes. Must be a valid CIDR that doesn't overlap with `service_cidr` or `pod_cidr`.",
 				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{cidrValidator("service_cidr", "pod_cidr")},
 			},
 			"proxy": schema.SingleNestedAttribute{
 				Description: "proxy",
 				Attributes:  proxy.ProxyResource(),
 				Optional:    true,
 			},
+			"default_ingress": schema.SingleNestedAttribute{
+				Description: "Settings of the default ingress of the cluster. Requires 'wait' to be " +
+					"enabled, as the settings can only be applied once the cluster is ready.",
+				Attributes: defaultIngressResource(),
+				Optional:   true,
+			},
+			"azure": schema.SingleNestedAttribute{
+				Description: "Settings of Azure hosted control plane clusters. Can only be set when " +
+					"'cloud_provider' is 'azure'. Changing it forces the replacement of the cluster.",
+				Attributes: azureResource(),
+				Optional:   true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Object{azureValidator()},
+			},
+			"shared_vpc": schema.SingleNestedAttribute{
+				Description: "Settings of the hosted zone of a shared VPC, owned by another AWS account. " +
+					"All the attributes must be given together. Changing it forces the replacement of the cluster.",
+				Attributes: sharedVPCResource(),
+				Optional:   true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Object{sharedVPCValidator()},
+			},
 			"service_cidr": schema.StringAttribute{
-				Description: "Block of IP addresses for services.",
+				Description: "Block of IP addresses for services. Must be a valid CIDR that doesn't overlap with `pod_cidr`.",
 				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{cidrValidator("pod_cidr")},
 			},
 			"pod_cidr": schema.StringAttribute{
-				Description: "Block of IP addresses for pods.",
+				Description: "Block of IP addresses for pods. Must be a valid CIDR.",
 				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{cidrValidator()},
 			},
 			"host_prefix": schema.Int64Attribute{
 				Description: "Length of the prefix of the subnet assigned to each node.",
@@ -269,6 +497,12 @@ func createClusterObject(ctx context.Context,
 	if common.HasValue(state.MultiAZ) {
 		builder.MultiAZ(state.MultiAZ.ValueBool())
 	}
+	if common.HasValue(state.FIPS) {
+		builder.FIPS(state.FIPS.ValueBool())
+	}
+	if common.HasValue(state.DisableWorkloadMonitoring) {
+		builder.DisableUserWorkloadMonitoring(state.DisableWorkloadMonitoring.ValueBool())
+	}
 	if common.HasValue(state.Properties) {
 		properties := map[string]string{}
 		propertiesElements, err := common.OptionalMap(ctx, state.Properties)
@@ -284,12 +518,27 @@ func createClusterObject(ctx context.Context,
 	if common.HasValue(state.ComputeNodes) {
 		nodes.Compute(int(state.ComputeNodes.ValueInt64()))
 	}
+	if common.BoolWithFalseDefault(state.AutoScalingEnabled) {
+		nodes.AutoscaleCompute(
+			cmv1.NewMachinePoolAutoscaling().
+				MinReplicas(int(state.MinReplicas.ValueInt64())).
+				MaxReplicas(int(state.MaxReplicas.ValueInt64())),
+		)
+	}
 	if common.HasValue(state.ComputeMachineType) {
 		nodes.ComputeMachineType(
 			cmv1.NewMachineType().ID(state.ComputeMachineType.ValueString()),
 		)
 	}
 
+	if common.HasValue(state.DefaultMachinePoolLabels) {
+		labels, err := common.OptionalMap(ctx, state.DefaultMachinePoolLabels)
+		if err != nil {
+			return nil, err
+		}
+		nodes.ComputeLabels(labels)
+	}
+
 	if common.HasValue(state.AvailabilityZones) {
 		availabilityZones, err := common.StringListToArray(ctx, state.AvailabilityZones)
 		if err != nil {
@@ -326,6 +575,9 @@ func createClusterObject(ctx context.Context,
 		}
 		builder.API(api)
 	}
+	if common.HasValue(state.APIListening) {
+		builder.API(cmv1.NewClusterAPI().Listening(cmv1.ListeningMethod(state.APIListening.ValueString())))
+	}
 
 	if common.HasValue(state.AWSSubnetIDs) {
 		subnetIds, err := common.StringListToArray(ctx, state.AWSSubnetIDs)
@@ -359,6 +611,32 @@ func createClusterObject(ctx context.Context,
 		aws.AdditionalControlPlaneSecurityGroupIds(controlSecurityGroupIds...)
 	}
 
+	if common.HasValue(state.EtcdEncryption) && state.EtcdEncryption.ValueBool() {
+		builder.EtcdEncryption(true)
+		aws.EtcdEncryption(cmv1.NewAwsEtcdEncryption().KMSKeyARN(state.EtcdEncryptionKMSKeyARN.ValueString()))
+	}
+
+	if state.SharedVPC != nil {
+		buildSharedVPC(state.SharedVPC, builder, aws)
+	}
+
+	gcp, err := buildGCP(state)
+	if err != nil {
+		return nil, err
+	}
+	if gcp != nil {
+		builder.GCP(gcp)
+	}
+
+	if state.Azure != nil {
+		azure, err := buildAzure(ctx, state.Azure)
+		if err != nil {
+			return nil, err
+		}
+		builder.Azure(azure)
+		builder.Hypershift(cmv1.NewHypershift().Enabled(true))
+	}
+
 	if !aws.Empty() {
 		builder.AWS(aws)
 	}
@@ -389,11 +667,79 @@ func createClusterObject(ctx context.Context,
 		builder.Proxy(proxyObj)
 	}
 
+	username := state.AdminUsername.ValueString()
+	password := state.AdminPassword.ValueString()
+	if common.BoolWithFalseDefault(state.CreateAdminUser) || username != "" || password != "" {
+		if username == "" {
+			username = commonutils.ClusterAdminUsername
+		}
+		if password == "" {
+			var err error
+			password, err = idputils.GenerateRandomPassword()
+			if err != nil {
+				return nil, err
+			}
+		}
+		hashedPassword, err := idputils.GenerateHTPasswdCompatibleHash(password)
+		if err != nil {
+			return nil, err
+		}
+		if os.Getenv("IS_TEST") == "true" {
+			hashedPassword = fmt.Sprintf("hash(%s)", password)
+		}
+		builder.Htpasswd(cmv1.NewHTPasswdIdentityProvider().Users(
+			cmv1.NewHTPasswdUserList().Items(
+				cmv1.NewHTPasswdUser().Username(username).HashedPassword(hashedPassword),
+			),
+		))
+	}
+	state.AdminCredentials = rosaTypes.FlattenAdminCredentials(username, password)
+
 	object, err := builder.Build()
 
 	return object, err
 }
 
+func (r *ClusterResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest,
+	response *resource.ValidateConfigResponse) {
+	var defaultIngress *DefaultIngress
+	diags := request.Config.GetAttribute(ctx, path.Root("default_ingress"), &defaultIngress)
+	response.Diagnostics.Append(diags...)
+	var wait types.Bool
+	diags = request.Config.GetAttribute(ctx, path.Root("wait"), &wait)
+	response.Diagnostics.Append(diags...)
+	var autoscalingEnabled types.Bool
+	diags = request.Config.GetAttribute(ctx, path.Root("autoscaling_enabled"), &autoscalingEnabled)
+	response.Diagnostics.Append(diags...)
+	var minReplicas, maxReplicas types.Int64
+	diags = request.Config.GetAttribute(ctx, path.Root("min_replicas"), &minReplicas)
+	response.Diagnostics.Append(diags...)
+	diags = request.Config.GetAttribute(ctx, path.Root("max_replicas"), &maxReplicas)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The default ingress can only be updated once the cluster is ready:
+	if defaultIngress != nil && !wait.IsUnknown() && !wait.IsNull() && !wait.ValueBool() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("default_ingress"),
+			"Invalid configuration",
+			"Attribute 'default_ingress' requires 'wait' to be enabled",
+		)
+	}
+
+	// The limits of the autoscaling are required when it's enabled:
+	if !autoscalingEnabled.IsUnknown() && common.BoolWithFalseDefault(autoscalingEnabled) &&
+		(minReplicas.IsNull() || maxReplicas.IsNull()) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("autoscaling_enabled"),
+			"Invalid configuration",
+			"Attributes 'min_replicas' and 'max_replicas' are required when 'autoscaling_enabled' is 'true'",
+		)
+	}
+}
+
 func (r *ClusterResource) Create(ctx context.Context, request resource.CreateRequest,
 	response *resource.CreateResponse) {
 	// Get the plan:
@@ -465,6 +811,22 @@ func (r *ClusterResource) Create(ctx context.Context, request resource.CreateReq
 		)
 		return
 	}
+
+	// Apply the default ingress settings. The cluster already exists at this point, so the state
+	// is saved even if this fails, otherwise it wouldn't be possible to destroy it later.
+	if state.DefaultIngress != nil {
+		err = r.updateDefaultIngress(ctx, object.ID(), state.DefaultIngress)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't update default ingress",
+				fmt.Sprintf(
+					"Can't update default ingress of cluster with identifier '%s': %v",
+					object.ID(), err,
+				),
+			)
+			state.DefaultIngress = nil
+		}
+	}
 	diags = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diags...)
 }
@@ -504,6 +866,27 @@ func (r *ClusterResource) Read(ctx context.Context, request resource.ReadRequest
 		)
 		return
 	}
+	if state.DefaultIngress != nil {
+		ingress, err := defaultingress.FindDefaultIngress(ctx, r.collection, object.ID())
+		if err == defaultingress.ErrNotFound {
+			// The settings were never applied or the ingress was removed, so let the next plan
+			// put them back:
+			state.DefaultIngress = nil
+			err = nil
+		} else if err == nil {
+			err = populateDefaultIngressState(ingress, state.DefaultIngress)
+		}
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't find default ingress",
+				fmt.Sprintf(
+					"Can't find default ingress of cluster with identifier '%s': %v",
+					object.ID(), err,
+				),
+			)
+			return
+		}
+	}
 	diags = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diags...)
 }
@@ -538,6 +921,11 @@ func (r *ClusterResource) Update(ctx context.Context, request resource.UpdateReq
 	if !nodes.Empty() {
 		builder.Nodes(nodes)
 	}
+	disableWorkloadMonitoring, ok := common.ShouldPatchBool(state.DisableWorkloadMonitoring,
+		plan.DisableWorkloadMonitoring)
+	if ok {
+		builder.DisableUserWorkloadMonitoring(disableWorkloadMonitoring)
+	}
 	patch, err := builder.Build()
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -575,6 +963,22 @@ func (r *ClusterResource) Update(ctx context.Context, request resource.UpdateReq
 		)
 		return
 	}
+
+	// Update the default ingress:
+	state.DefaultIngress = plan.DefaultIngress
+	if state.DefaultIngress != nil {
+		err = r.updateDefaultIngress(ctx, object.ID(), state.DefaultIngress)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't update default ingress",
+				fmt.Sprintf(
+					"Can't update default ingress of cluster with identifier '%s': %v",
+					object.ID(), err,
+				),
+			)
+			return
+		}
+	}
 	diags = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diags...)
 }
@@ -636,6 +1040,268 @@ func (r *ClusterResource) ImportState(ctx context.Context, request resource.Impo
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
+// labelKeysValidator checks that the keys of the labels are valid Kubernetes label names.
+func labelKeysValidator() validator.Map {
+	return attrvalidators.NewMapValidator("label keys validator", func(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		for key := range req.ConfigValue.Elements() {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				resp.Diagnostics.AddAttributeError(req.Path, "invalid label key",
+					fmt.Sprintf("'%s' is not a valid label key: %s", key, strings.Join(errs, "; ")),
+				)
+			}
+		}
+	})
+}
+
+// apiListeningValidator checks that the API listening method is 'internal' when
+// 'aws_private_link' is enabled.
+func apiListeningValidator() validator.String {
+	return attrvalidators.NewStringValidator("api listening validator", func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		privateLink := types.BoolNull()
+		diags := req.Config.GetAttribute(ctx, path.Root("aws_private_link"), &privateLink)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if common.HasValue(privateLink) && privateLink.ValueBool() &&
+			req.ConfigValue.ValueString() != string(cmv1.ListeningMethodInternal) {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid api listening",
+				fmt.Sprintf("'%s' api listening can't be used with 'aws_private_link', it must be '%s'",
+					req.ConfigValue.ValueString(), cmv1.ListeningMethodInternal),
+			)
+		}
+	})
+}
+
+// domainPrefixValidator checks that the domain prefix is a valid DNS label that isn't longer than
+// the maximum allowed by OCM.
+func domainPrefixValidator() validator.String {
+	return attrvalidators.NewStringValidator("domain prefix validator", func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		value := req.ConfigValue.ValueString()
+		errs := validation.IsDNS1035Label(value)
+		if len(value) > rosa.MaxClusterDomainPrefixLength {
+			errs = append(errs, fmt.Sprintf("must be no more than %d characters",
+				rosa.MaxClusterDomainPrefixLength))
+		}
+		if len(errs) > 0 {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid domain prefix",
+				fmt.Sprintf("'%s' is not a valid domain prefix: %s", value, strings.Join(errs, "; ")),
+			)
+		}
+	})
+}
+
+// availabilityZonesValidator checks that the number of availability zones matches the value of
+// the 'multi_az' attribute.
+func availabilityZonesValidator() validator.List {
+	return attrvalidators.NewListValidator("availability zones validator", func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		multiAZ := types.BoolNull()
+		diags := req.Config.GetAttribute(ctx, path.Root("multi_az"), &multiAZ)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if multiAZ.IsUnknown() {
+			return
+		}
+		expected, kind := 1, "single zone"
+		if common.BoolWithFalseDefault(multiAZ) {
+			expected, kind = 3, "multi zone"
+		}
+		if len(req.ConfigValue.Elements()) != expected {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid number of availability zones",
+				fmt.Sprintf("%d availability zones were given but %s clusters require %d",
+					len(req.ConfigValue.Elements()), kind, expected),
+			)
+		}
+	})
+}
+
+// computeNodesValidator checks that the number of compute nodes is positive and, for multi zone
+// clusters, that the nodes can be spread evenly across the three availability zones.
+func computeNodesValidator() validator.Int64 {
+	return attrvalidators.NewInt64Validator("compute nodes validator", func(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		computeNodes := req.ConfigValue.ValueInt64()
+		if computeNodes < 1 {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid number of compute nodes",
+				fmt.Sprintf("the number of compute nodes must be at least 1, but %d was given", computeNodes),
+			)
+			return
+		}
+		multiAZ := types.BoolNull()
+		diags := req.Config.GetAttribute(ctx, path.Root("multi_az"), &multiAZ)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if multiAZ.IsUnknown() {
+			return
+		}
+		if common.BoolWithFalseDefault(multiAZ) && computeNodes%3 != 0 {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid number of compute nodes",
+				fmt.Sprintf("multi zone clusters require a multiple of 3 compute nodes, but %d was given",
+					computeNodes),
+			)
+		}
+		autoscalingEnabled := types.BoolNull()
+		diags = req.Config.GetAttribute(ctx, path.Root("autoscaling_enabled"), &autoscalingEnabled)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if common.BoolWithFalseDefault(autoscalingEnabled) {
+			resp.Diagnostics.AddAttributeError(req.Path, "conflicting compute nodes settings",
+				"'compute_nodes' can't be set when 'autoscaling_enabled' is 'true'",
+			)
+		}
+	})
+}
+
+// autoscalingReplicasValidator checks the minimum and maximum replicas of the default compute
+// pool: they can only be set when autoscaling is enabled, they must be positive and, for multi
+// zone clusters, a multiple of 3, and the minimum can't be greater than the maximum.
+func autoscalingReplicasValidator() validator.Int64 {
+	return attrvalidators.NewInt64Validator("autoscaling replicas validator", func(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		attribute := req.Path.String()
+		replicas := req.ConfigValue.ValueInt64()
+		autoscalingEnabled := types.BoolNull()
+		diags := req.Config.GetAttribute(ctx, path.Root("autoscaling_enabled"), &autoscalingEnabled)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if autoscalingEnabled.IsUnknown() {
+			return
+		}
+		if !common.BoolWithFalseDefault(autoscalingEnabled) {
+			resp.Diagnostics.AddAttributeError(req.Path, "autoscaling isn't enabled",
+				fmt.Sprintf("'%s' can only be set when 'autoscaling_enabled' is 'true'", attribute),
+			)
+			return
+		}
+		if replicas < 1 {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid number of replicas",
+				fmt.Sprintf("'%s' must be at least 1, but %d was given", attribute, replicas),
+			)
+			return
+		}
+		multiAZ := types.BoolNull()
+		diags = req.Config.GetAttribute(ctx, path.Root("multi_az"), &multiAZ)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if !multiAZ.IsUnknown() && common.BoolWithFalseDefault(multiAZ) && replicas%3 != 0 {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid number of replicas",
+				fmt.Sprintf("multi zone clusters require a multiple of 3 for '%s', but %d was given",
+					attribute, replicas),
+			)
+		}
+		if attribute != "max_replicas" {
+			return
+		}
+		minReplicas := types.Int64Null()
+		diags = req.Config.GetAttribute(ctx, path.Root("min_replicas"), &minReplicas)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if common.HasValue(minReplicas) && minReplicas.ValueInt64() > replicas {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid number of replicas",
+				fmt.Sprintf("'min_replicas' can't be greater than 'max_replicas', but %d and %d were given",
+					minReplicas.ValueInt64(), replicas),
+			)
+		}
+	})
+}
+
+// etcdEncryptionKMSKeyARNValidator checks that the KMS key ARN is given when etcd encryption is
+// enabled, and only in that case.
+func etcdEncryptionKMSKeyARNValidator() validator.String {
+	return attrvalidators.NewStringValidator("etcd encryption KMS key ARN validator", func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+		if req.ConfigValue.IsUnknown() {
+			return
+		}
+		etcdEncryption := types.BoolNull()
+		diags := req.Config.GetAttribute(ctx, path.Root("etcd_encryption"), &etcdEncryption)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if etcdEncryption.IsUnknown() {
+			return
+		}
+		enabled := common.BoolWithFalseDefault(etcdEncryption)
+		if enabled && req.ConfigValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(req.Path, "missing etcd encryption KMS key ARN",
+				"'etcd_encryption_kms_key_arn' is required when 'etcd_encryption' is 'true'",
+			)
+		}
+		if !enabled && !req.ConfigValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(req.Path, "unexpected etcd encryption KMS key ARN",
+				"'etcd_encryption_kms_key_arn' can only be set when 'etcd_encryption' is 'true'",
+			)
+		}
+	})
+}
+
+// cidrValidator checks that the value is a well formed CIDR that doesn't overlap with the CIDRs
+// of the given attributes.
+func cidrValidator(others ...string) validator.String {
+	return attrvalidators.NewStringValidator("cidr validator", func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		_, network, err := net.ParseCIDR(req.ConfigValue.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid CIDR",
+				fmt.Sprintf("'%s' is not a valid CIDR: %v", req.ConfigValue.ValueString(), err),
+			)
+			return
+		}
+		for _, other := range others {
+			otherValue := types.StringNull()
+			diags := req.Config.GetAttribute(ctx, path.Root(other), &otherValue)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			if !common.HasValue(otherValue) {
+				continue
+			}
+			// Malformed values are reported by the validator of the other attribute:
+			_, otherNetwork, err := net.ParseCIDR(otherValue.ValueString())
+			if err != nil {
+				continue
+			}
+			if network.Contains(otherNetwork.IP) || otherNetwork.Contains(network.IP) {
+				resp.Diagnostics.AddAttributeError(req.Path, "overlapping CIDRs",
+					fmt.Sprintf("'%s' overlaps with '%s' of '%s'",
+						req.ConfigValue.ValueString(), otherValue.ValueString(), other),
+				)
+			}
+		}
+	})
+}
+
 // populateClusterState copies the data from the API object to the Terraform state.
 func populateClusterState(object *cmv1.Cluster, state *ClusterState) error {
 	state.ID = types.StringValue(object.ID())
@@ -647,6 +1313,15 @@ func populateClusterState(object *cmv1.Cluster, state *ClusterState) error {
 	state.CloudProvider = types.StringValue(object.CloudProvider().ID())
 	state.CloudRegion = types.StringValue(object.Region().ID())
 	state.MultiAZ = types.BoolValue(object.MultiAZ())
+	state.FIPS = types.BoolValue(object.FIPS())
+	state.DisableWorkloadMonitoring = types.BoolValue(object.DisableUserWorkloadMonitoring())
+	state.EtcdEncryption = types.BoolValue(object.EtcdEncryption())
+	etcdKMSKeyARN, ok := object.AWS().EtcdEncryption().GetKMSKeyARN()
+	if ok && object.EtcdEncryption() {
+		state.EtcdEncryptionKMSKeyARN = types.StringValue(etcdKMSKeyARN)
+	} else {
+		state.EtcdEncryptionKMSKeyARN = types.StringNull()
+	}
 
 	mapValue, err := common.ConvertStringMapToMapType(object.Properties())
 	if err != nil {
@@ -657,9 +1332,25 @@ func populateClusterState(object *cmv1.Cluster, state *ClusterState) error {
 
 	state.APIURL = types.StringValue(object.API().URL())
 	state.ConsoleURL = types.StringValue(object.Console().URL())
+	state.OAuthURL = types.StringValue(common.OAuthURL(object))
+	listening, ok := object.API().GetListening()
+	if ok {
+		state.APIListening = types.StringValue(string(listening))
+	} else {
+		state.APIListening = types.StringValue(string(cmv1.ListeningMethodExternal))
+	}
 	state.ComputeNodes = types.Int64Value(int64(object.Nodes().Compute()))
 	state.ComputeMachineType = types.StringValue(object.Nodes().ComputeMachineType().ID())
 
+	labels, ok := object.Nodes().GetComputeLabels()
+	if ok && len(labels) > 0 {
+		labelsValue, err := common.ConvertStringMapToMapType(labels)
+		if err != nil {
+			return err
+		}
+		state.DefaultMachinePoolLabels = labelsValue
+	}
+
 	azs, ok := object.Nodes().GetAvailabilityZones()
 	if ok {
 		listValue, err := common.StringArrayToList(azs)
@@ -676,6 +1367,12 @@ func populateClusterState(object *cmv1.Cluster, state *ClusterState) error {
 	if ok {
 		state.AWSAccountID = types.StringValue(awsAccountID)
 	}
+	gcpProjectID, ok := object.GCP().GetProjectID()
+	if ok {
+		state.GCPProjectID = types.StringValue(gcpProjectID)
+	} else {
+		state.GCPProjectID = types.StringNull()
+	}
 	awsAccessKeyID, ok := object.AWS().GetAccessKeyID()
 	if ok {
 		state.AWSAccessKeyID = types.StringValue(awsAccessKeyID)
@@ -721,8 +1418,22 @@ func populateClusterState(object *cmv1.Cluster, state *ClusterState) error {
 
 	proxyObj, ok := object.GetProxy()
 	if ok {
-		state.Proxy.HttpProxy = types.StringValue(proxyObj.HTTPProxy())
-		state.Proxy.HttpsProxy = types.StringValue(proxyObj.HTTPSProxy())
+		// The proxy isn't in the state yet when the cluster is imported:
+		if state.Proxy == nil {
+			state.Proxy = &proxy.Proxy{}
+		}
+		httpProxy, ok := proxyObj.GetHTTPProxy()
+		if ok && httpProxy != "" {
+			state.Proxy.HttpProxy = types.StringValue(httpProxy)
+		}
+		httpsProxy, ok := proxyObj.GetHTTPSProxy()
+		if ok && httpsProxy != "" {
+			state.Proxy.HttpsProxy = types.StringValue(httpsProxy)
+		}
+		noProxy, ok := proxyObj.GetNoProxy()
+		if ok && noProxy != "" {
+			state.Proxy.NoProxy = types.StringValue(noProxy)
+		}
 	}
 
 	machineCIDR, ok := object.Network().GetMachineCIDR()
@@ -755,6 +1466,11 @@ func populateClusterState(object *cmv1.Cluster, state *ClusterState) error {
 	} else {
 		state.Version = types.StringNull()
 	}
+	state.SharedVPC = populateSharedVPCState(object)
+	state.Azure, err = populateAzureState(object)
+	if err != nil {
+		return err
+	}
 	state.State = types.StringValue(string(object.State()))
 
 	return nil
@@ -23,34 +23,53 @@ import (
 )
 
 type ClusterState struct {
-	APIURL                                    types.String `tfsdk:"api_url"`
-	AWSAccessKeyID                            types.String `tfsdk:"aws_access_key_id"`
-	AWSAccountID                              types.String `tfsdk:"aws_account_id"`
-	AWSSecretAccessKey                        types.String `tfsdk:"aws_secret_access_key"`
-	AWSSubnetIDs                              types.List   `tfsdk:"aws_subnet_ids"`
-	AWSAdditionalComputeSecurityGroupIds      types.List   `tfsdk:"aws_additional_compute_security_group_ids"`
-	AWSAdditionalInfraSecurityGroupIds        types.List   `tfsdk:"aws_additional_infra_security_group_ids"`
-	AWSAdditionalControlPlaneSecurityGroupIds types.List   `tfsdk:"aws_additional_control_plane_security_group_ids"`
-	AWSPrivateLink                            types.Bool   `tfsdk:"aws_private_link"`
-	CCSEnabled                                types.Bool   `tfsdk:"ccs_enabled"`
-	CloudProvider                             types.String `tfsdk:"cloud_provider"`
-	CloudRegion                               types.String `tfsdk:"cloud_region"`
-	ComputeMachineType                        types.String `tfsdk:"compute_machine_type"`
-	ComputeNodes                              types.Int64  `tfsdk:"compute_nodes"`
-	ConsoleURL                                types.String `tfsdk:"console_url"`
-	HostPrefix                                types.Int64  `tfsdk:"host_prefix"`
-	ID                                        types.String `tfsdk:"id"`
-	Product                                   types.String `tfsdk:"product"`
-	MachineCIDR                               types.String `tfsdk:"machine_cidr"`
-	MultiAZ                                   types.Bool   `tfsdk:"multi_az"`
-	AvailabilityZones                         types.List   `tfsdk:"availability_zones"`
-	Name                                      types.String `tfsdk:"name"`
-	DomainPrefix                              types.String `tfsdk:"domain_prefix"`
-	PodCIDR                                   types.String `tfsdk:"pod_cidr"`
-	Properties                                types.Map    `tfsdk:"properties"`
-	ServiceCIDR                               types.String `tfsdk:"service_cidr"`
-	Proxy                                     *proxy.Proxy `tfsdk:"proxy"`
-	State                                     types.String `tfsdk:"state"`
-	Version                                   types.String `tfsdk:"version"`
-	Wait                                      types.Bool   `tfsdk:"wait"`
+	AdminCredentials                          types.Object    `tfsdk:"admin_credentials"`
+	AdminPassword                             types.String    `tfsdk:"admin_password"`
+	AdminUsername                             types.String    `tfsdk:"admin_username"`
+	APIURL                                    types.String    `tfsdk:"api_url"`
+	APIListening                              types.String    `tfsdk:"api_listening"`
+	AWSAccessKeyID                            types.String    `tfsdk:"aws_access_key_id"`
+	AWSAccountID                              types.String    `tfsdk:"aws_account_id"`
+	AWSSecretAccessKey                        types.String    `tfsdk:"aws_secret_access_key"`
+	AWSSubnetIDs                              types.List      `tfsdk:"aws_subnet_ids"`
+	AWSAdditionalComputeSecurityGroupIds      types.List      `tfsdk:"aws_additional_compute_security_group_ids"`
+	AWSAdditionalInfraSecurityGroupIds        types.List      `tfsdk:"aws_additional_infra_security_group_ids"`
+	AWSAdditionalControlPlaneSecurityGroupIds types.List      `tfsdk:"aws_additional_control_plane_security_group_ids"`
+	AWSPrivateLink                            types.Bool      `tfsdk:"aws_private_link"`
+	AutoScalingEnabled                        types.Bool      `tfsdk:"autoscaling_enabled"`
+	Azure                                     *Azure          `tfsdk:"azure"`
+	CCSEnabled                                types.Bool      `tfsdk:"ccs_enabled"`
+	CloudProvider                             types.String    `tfsdk:"cloud_provider"`
+	CloudRegion                               types.String    `tfsdk:"cloud_region"`
+	ComputeMachineType                        types.String    `tfsdk:"compute_machine_type"`
+	ComputeNodes                              types.Int64     `tfsdk:"compute_nodes"`
+	ConsoleURL                                types.String    `tfsdk:"console_url"`
+	CreateAdminUser                           types.Bool      `tfsdk:"create_admin_user"`
+	DefaultIngress                            *DefaultIngress `tfsdk:"default_ingress"`
+	DefaultMachinePoolLabels                  types.Map       `tfsdk:"default_machine_pool_labels"`
+	DisableWorkloadMonitoring                 types.Bool      `tfsdk:"disable_workload_monitoring"`
+	EtcdEncryption                            types.Bool      `tfsdk:"etcd_encryption"`
+	EtcdEncryptionKMSKeyARN                   types.String    `tfsdk:"etcd_encryption_kms_key_arn"`
+	FIPS                                      types.Bool      `tfsdk:"fips"`
+	GCPProjectID                              types.String    `tfsdk:"gcp_project_id"`
+	GCPServiceAccountKey                      types.String    `tfsdk:"gcp_service_account_key"`
+	HostPrefix                                types.Int64     `tfsdk:"host_prefix"`
+	ID                                        types.String    `tfsdk:"id"`
+	Product                                   types.String    `tfsdk:"product"`
+	MachineCIDR                               types.String    `tfsdk:"machine_cidr"`
+	MaxReplicas                               types.Int64     `tfsdk:"max_replicas"`
+	MinReplicas                               types.Int64     `tfsdk:"min_replicas"`
+	MultiAZ                                   types.Bool      `tfsdk:"multi_az"`
+	AvailabilityZones                         types.List      `tfsdk:"availability_zones"`
+	Name                                      types.String    `tfsdk:"name"`
+	OAuthURL                                  types.String    `tfsdk:"oauth_url"`
+	DomainPrefix                              types.String    `tfsdk:"domain_prefix"`
+	PodCIDR                                   types.String    `tfsdk:"pod_cidr"`
+	Properties                                types.Map       `tfsdk:"properties"`
+	ServiceCIDR                               types.String    `tfsdk:"service_cidr"`
+	SharedVPC                                 *SharedVPC      `tfsdk:"shared_vpc"`
+	Proxy                                     *proxy.Proxy    `tfsdk:"proxy"`
+	State                                     types.String    `tfsdk:"state"`
+	Version                                   types.String    `tfsdk:"version"`
+	Wait                                      types.Bool      `tfsdk:"wait"`
 }
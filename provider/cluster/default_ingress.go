@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/defaultingress"
+)
+
+type DefaultIngress struct {
+	ListeningMethod          types.String `tfsdk:"listening_method"`
+	RouteSelectors           types.Map    `tfsdk:"route_selectors"`
+	ExcludedNamespaces       types.List   `tfsdk:"excluded_namespaces"`
+	WildcardPolicy           types.String `tfsdk:"route_wildcard_policy"`
+	NamespaceOwnershipPolicy types.String `tfsdk:"route_namespace_ownership_policy"`
+}
+
+func (i *DefaultIngress) routeSettings() defaultingress.RouteSettings {
+	return defaultingress.RouteSettings{
+		RouteSelectors:           i.RouteSelectors,
+		ExcludedNamespaces:       i.ExcludedNamespaces,
+		WildcardPolicy:           i.WildcardPolicy,
+		NamespaceOwnershipPolicy: i.NamespaceOwnershipPolicy,
+	}
+}
+
+func defaultIngressResource() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"listening_method": schema.StringAttribute{
+			Description: fmt.Sprintf("Listening method of the default ingress. Options are %s.",
+				strings.Join(validAPIListeningMethods, ",")),
+			Optional:   true,
+			Computed:   true,
+			Validators: []validator.String{attrvalidators.EnumValueValidator(validAPIListeningMethods)},
+		},
+		"route_selectors": schema.MapAttribute{
+			Description: "Route selectors of the default ingress. " +
+				"If no label is specified, all routes will be exposed on the default router.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  []validator.Map{attrvalidators.NotEmptyMapValidator()},
+		},
+		"excluded_namespaces": schema.ListAttribute{
+			Description: "Namespaces excluded from the default ingress. " +
+				"If no values are specified, all namespaces will be exposed.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+		},
+		"route_wildcard_policy": schema.StringAttribute{
+			Description: fmt.Sprintf("Wildcard policy of the default ingress. Options are %s.",
+				strings.Join(defaultingress.ValidWildcardPolicies, ",")),
+			Optional:   true,
+			Computed:   true,
+			Validators: []validator.String{attrvalidators.EnumValueValidator(defaultingress.ValidWildcardPolicies)},
+		},
+		"route_namespace_ownership_policy": schema.StringAttribute{
+			Description: fmt.Sprintf("Namespace ownership policy of the default ingress. Options are %s.",
+				strings.Join(defaultingress.ValidNamespaceOwnershipPolicies, ",")),
+			Optional:   true,
+			Computed:   true,
+			Validators: []validator.String{attrvalidators.EnumValueValidator(defaultingress.ValidNamespaceOwnershipPolicies)},
+		},
+	}
+}
+
+// updateDefaultIngress applies the given settings to the default ingress of the cluster and
+// copies the result back to them.
+func (r *ClusterResource) updateDefaultIngress(ctx context.Context, clusterID string,
+	state *DefaultIngress) error {
+	ingress, err := r.applyDefaultIngress(ctx, clusterID, state)
+	if err != nil {
+		return err
+	}
+	return populateDefaultIngressState(ingress, state)
+}
+
+// applyDefaultIngress updates the default ingress of the cluster so that it matches the plan. Only
+// the settings that differ from the current ingress are sent to the server.
+func (r *ClusterResource) applyDefaultIngress(ctx context.Context, clusterID string,
+	plan *DefaultIngress) (*cmv1.Ingress, error) {
+	ingress, err := defaultingress.FindDefaultIngress(ctx, r.collection, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	current := &DefaultIngress{}
+	if err := populateDefaultIngressState(ingress, current); err != nil {
+		return nil, err
+	}
+
+	builder := cmv1.NewIngress()
+	changed, err := defaultingress.AddRouteSettings(ctx, builder, current.routeSettings(), plan.routeSettings())
+	if err != nil {
+		return nil, err
+	}
+	if common.HasValue(plan.ListeningMethod) && !plan.ListeningMethod.Equal(current.ListeningMethod) {
+		builder.Listening(cmv1.ListeningMethod(plan.ListeningMethod.ValueString()))
+		changed = true
+	}
+	if !changed {
+		return ingress, nil
+	}
+
+	patch, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	update, err := r.collection.Cluster(clusterID).Ingresses().Ingress(ingress.ID()).Update().
+		Body(patch).
+		SendContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return update.Body(), nil
+}
+
+// populateDefaultIngressState copies the data from the API object to the Terraform state.
+func populateDefaultIngressState(ingress *cmv1.Ingress, state *DefaultIngress) error {
+	var err error
+	state.ListeningMethod = types.StringValue(string(ingress.Listening()))
+
+	routeSelectors, ok := ingress.GetRouteSelectors()
+	if ok && len(routeSelectors) > 0 {
+		state.RouteSelectors, err = common.ConvertStringMapToMapType(routeSelectors)
+		if err != nil {
+			return err
+		}
+	} else {
+		state.RouteSelectors = types.MapNull(types.StringType)
+	}
+
+	excludedNamespaces, ok := ingress.GetExcludedNamespaces()
+	if ok && len(excludedNamespaces) > 0 {
+		state.ExcludedNamespaces, err = common.StringArrayToList(excludedNamespaces)
+		if err != nil {
+			return err
+		}
+	} else {
+		state.ExcludedNamespaces = types.ListNull(types.StringType)
+	}
+
+	wildcardPolicy, ok := ingress.GetRouteWildcardPolicy()
+	if ok {
+		state.WildcardPolicy = types.StringValue(string(wildcardPolicy))
+	} else {
+		state.WildcardPolicy = types.StringNull()
+	}
+	namespaceOwnershipPolicy, ok := ingress.GetRouteNamespaceOwnershipPolicy()
+	if ok {
+		state.NamespaceOwnershipPolicy = types.StringValue(string(namespaceOwnershipPolicy))
+	} else {
+		state.NamespaceOwnershipPolicy = types.StringNull()
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+)
+
+const gcpCloudProvider = "gcp"
+
+// gcpServiceAccountKey is the content of the JSON key file of a GCP service account.
+type gcpServiceAccountKey struct {
+	Type                    string `json:"type"`
+	ProjectID               string `json:"project_id"`
+	PrivateKeyID            string `json:"private_key_id"`
+	PrivateKey              string `json:"private_key"`
+	ClientEmail             string `json:"client_email"`
+	ClientID                string `json:"client_id"`
+	AuthURI                 string `json:"auth_uri"`
+	TokenURI                string `json:"token_uri"`
+	AuthProviderX509CertURL string `json:"auth_provider_x509_cert_url"`
+	ClientX509CertURL       string `json:"client_x509_cert_url"`
+}
+
+func parseGCPServiceAccountKey(text string) (*gcpServiceAccountKey, error) {
+	key := &gcpServiceAccountKey{}
+	err := json.Unmarshal([]byte(text), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GCP service account key: %v", err)
+	}
+	return key, nil
+}
+
+// gcpOnlyValidator checks that the attribute is only set for GCP clusters.
+func gcpOnlyValidator() validator.String {
+	return attrvalidators.NewStringValidator("GCP only validator", func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+		if req.ConfigValue.IsNull() {
+			return
+		}
+		cloudProvider := types.StringNull()
+		diags := req.Config.GetAttribute(ctx, path.Root("cloud_provider"), &cloudProvider)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if cloudProvider.IsUnknown() {
+			return
+		}
+		if cloudProvider.ValueString() != gcpCloudProvider {
+			resp.Diagnostics.AddAttributeError(req.Path, "unexpected GCP attribute",
+				fmt.Sprintf("'%s' can only be set when 'cloud_provider' is '%s'",
+					req.Path, gcpCloudProvider),
+			)
+		}
+	})
+}
+
+// gcpServiceAccountKeyValidator checks that the service account key is a valid JSON document.
+func gcpServiceAccountKeyValidator() validator.String {
+	return attrvalidators.NewStringValidator("GCP service account key validator", func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		if _, err := parseGCPServiceAccountKey(req.ConfigValue.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path, "invalid GCP service account key", err.Error())
+		}
+	})
+}
+
+// buildGCP returns the GCP settings of the cluster, or nil if there are none. The project
+// identifier given explicitly takes precedence over the one in the service account key.
+func buildGCP(state *ClusterState) (*cmv1.GCPBuilder, error) {
+	if !common.HasValue(state.GCPProjectID) && !common.HasValue(state.GCPServiceAccountKey) {
+		return nil, nil
+	}
+	gcp := cmv1.NewGCP()
+	if common.HasValue(state.GCPServiceAccountKey) {
+		key, err := parseGCPServiceAccountKey(state.GCPServiceAccountKey.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		gcp.Type(key.Type).
+			ProjectID(key.ProjectID).
+			PrivateKeyID(key.PrivateKeyID).
+			PrivateKey(key.PrivateKey).
+			ClientEmail(key.ClientEmail).
+			ClientID(key.ClientID).
+			AuthURI(key.AuthURI).
+			TokenURI(key.TokenURI).
+			AuthProviderX509CertURL(key.AuthProviderX509CertURL).
+			ClientX509CertURL(key.ClientX509CertURL)
+	}
+	if common.HasValue(state.GCPProjectID) {
+		gcp.ProjectID(state.GCPProjectID.ValueString())
+	}
+	return gcp, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+)
+
+type SharedVPC struct {
+	HostedZoneID  types.String `tfsdk:"hosted_zone_id"`
+	SharedRoleARN types.String `tfsdk:"shared_role_arn"`
+	BaseDNSDomain types.String `tfsdk:"base_dns_domain"`
+}
+
+func sharedVPCResource() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"hosted_zone_id": schema.StringAttribute{
+			Description: "ID of the private Route 53 hosted zone associated with the shared VPC, " +
+				"for example 'Z05646003S02O1ENCDCSN'.",
+			Optional: true,
+		},
+		"shared_role_arn": schema.StringAttribute{
+			Description: "ARN of the AWS IAM role, in the account that owns the shared VPC, " +
+				"that allows to manage the records of the hosted zone.",
+			Optional: true,
+		},
+		"base_dns_domain": schema.StringAttribute{
+			Description: "Base DNS domain of the hosted zone, for example 'example.com'.",
+			Optional:    true,
+		},
+	}
+}
+
+// sharedVPCValidator checks that all the attributes of the shared VPC settings are given
+// together.
+func sharedVPCValidator() validator.Object {
+	return attrvalidators.NewObjectValidator("shared VPC validator", func(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		sharedVPC := SharedVPC{}
+		diags := req.ConfigValue.As(ctx, &sharedVPC, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		names := []string{"hosted_zone_id", "shared_role_arn", "base_dns_domain"}
+		values := []types.String{sharedVPC.HostedZoneID, sharedVPC.SharedRoleARN, sharedVPC.BaseDNSDomain}
+		var missing []string
+		for i, value := range values {
+			if value.IsNull() || (!value.IsUnknown() && value.ValueString() == "") {
+				missing = append(missing, fmt.Sprintf("'%s'", names[i]))
+			}
+		}
+		if len(missing) > 0 {
+			resp.Diagnostics.AddAttributeError(req.Path, "incomplete shared VPC configuration",
+				fmt.Sprintf("'hosted_zone_id', 'shared_role_arn' and 'base_dns_domain' must be "+
+					"given together, missing %s", strings.Join(missing, ", ")),
+			)
+		}
+	})
+}
+
+// buildSharedVPC adds the shared VPC settings to the cluster and AWS builders.
+func buildSharedVPC(state *SharedVPC, builder *cmv1.ClusterBuilder, aws *cmv1.AWSBuilder) {
+	aws.PrivateHostedZoneID(state.HostedZoneID.ValueString())
+	aws.PrivateHostedZoneRoleARN(state.SharedRoleARN.ValueString())
+	builder.DNS(cmv1.NewDNS().BaseDomain(state.BaseDNSDomain.ValueString()))
+}
+
+// populateSharedVPCState copies the shared VPC settings from the API object to the Terraform
+// state. The result is nil if the cluster doesn't use a shared VPC.
+func populateSharedVPCState(object *cmv1.Cluster) *SharedVPC {
+	hostedZoneID, ok := object.AWS().GetPrivateHostedZoneID()
+	if !ok || hostedZoneID == "" {
+		return nil
+	}
+	return &SharedVPC{
+		HostedZoneID:  types.StringValue(hostedZoneID),
+		SharedRoleARN: types.StringValue(object.AWS().PrivateHostedZoneRoleARN()),
+		BaseDNSDomain: types.StringValue(object.DNS().BaseDomain()),
+	}
+}
@@ -0,0 +1,343 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteradmin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+const (
+	// clusterAdminIDPName is the name of the htpasswd identity provider where OCM creates the
+	// admin user of the cluster.
+	clusterAdminIDPName = "cluster-admin"
+
+	// clusterAdminsGroup is the group that gives the cluster-admin role to its members.
+	clusterAdminsGroup = "cluster-admins"
+)
+
+// ClusterAdminRevocationResource removes the admin user that OCM creates along with the cluster,
+// once other cluster admins have been set up. The removal can't be undone, so destroying the
+// resource only removes it from the Terraform state.
+type ClusterAdminRevocationResource struct {
+	clusterCollection *cmv1.ClustersClient
+	clusterWait       common.ClusterWait
+}
+
+var _ resource.ResourceWithConfigure = &ClusterAdminRevocationResource{}
+
+func New() resource.Resource {
+	return &ClusterAdminRevocationResource{}
+}
+
+func (r *ClusterAdminRevocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_admin_revocation"
+}
+
+func (r *ClusterAdminRevocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Revokes the admin user created by OCM for a cluster, the `cluster-admin` htpasswd " +
+			"identity provider, after other cluster admins have been set up. At least one other member of the " +
+			"`cluster-admins` group is required. The admin can't be restored, destroying this resource only " +
+			"removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster. " + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the revocation, the identifier of the cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterAdminRevocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connection, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clusterCollection = connection.ClustersMgmt().V1().Clusters()
+	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection)
+}
+
+func (r *ClusterAdminRevocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Get the plan:
+	state := &ClusterAdminRevocationState{}
+	diags := req.Plan.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterID := state.Cluster.ValueString()
+
+	// Wait till the cluster is ready:
+	waitTimeoutInMinutes := int64(60)
+	_, err := r.clusterWait.WaitForClusterToBeReady(ctx, clusterID, waitTimeoutInMinutes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't poll cluster state",
+			fmt.Sprintf(
+				"Can't poll state of cluster with identifier '%s': %v",
+				clusterID, err,
+			),
+		)
+		return
+	}
+
+	// Find the identity provider and the users of the admin:
+	idp, err := r.findClusterAdminIDP(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't find cluster admin",
+			fmt.Sprintf(
+				"Can't find the identity provider of the admin of cluster '%s': %v",
+				clusterID, err,
+			),
+		)
+		return
+	}
+	admins := map[string]bool{}
+	if idp != nil {
+		users, err := r.clusterCollection.Cluster(clusterID).IdentityProviders().
+			IdentityProvider(idp.ID()).
+			HtpasswdUsers().
+			List().
+			SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't find cluster admin",
+				fmt.Sprintf(
+					"Can't list the users of identity provider '%s' of cluster '%s': %v",
+					idp.ID(), clusterID, err,
+				),
+			)
+			return
+		}
+		for _, user := range users.Items().Slice() {
+			admins[user.Username()] = true
+		}
+	}
+
+	// Check that there will be at least one cluster admin left, otherwise the cluster could only
+	// be managed by Red Hat:
+	members, err := r.clusterCollection.Cluster(clusterID).Groups().
+		Group(clusterAdminsGroup).
+		Users().
+		List().
+		SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't revoke cluster admin",
+			fmt.Sprintf(
+				"Can't list the members of group '%s' of cluster '%s': %v",
+				clusterAdminsGroup, clusterID, err,
+			),
+		)
+		return
+	}
+	var revokedMembers []string
+	otherMembers := 0
+	for _, member := range members.Items().Slice() {
+		if admins[member.ID()] {
+			revokedMembers = append(revokedMembers, member.ID())
+		} else {
+			otherMembers++
+		}
+	}
+	if otherMembers == 0 {
+		resp.Diagnostics.AddError(
+			"Can't revoke cluster admin",
+			fmt.Sprintf(
+				"Cluster '%s' has no other member of group '%s', add another cluster admin "+
+					"before revoking the '%s' identity provider",
+				clusterID, clusterAdminsGroup, clusterAdminIDPName,
+			),
+		)
+		return
+	}
+
+	// Remove the admin from the group and then the identity provider, so that the user can't log
+	// in anymore:
+	for _, member := range revokedMembers {
+		_, err = r.clusterCollection.Cluster(clusterID).Groups().
+			Group(clusterAdminsGroup).
+			Users().
+			User(member).
+			Delete().
+			SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't revoke cluster admin",
+				fmt.Sprintf(
+					"Can't remove user '%s' from group '%s' of cluster '%s': %v",
+					member, clusterAdminsGroup, clusterID, err,
+				),
+			)
+			return
+		}
+	}
+	if idp != nil {
+		_, err = r.clusterCollection.Cluster(clusterID).IdentityProviders().
+			IdentityProvider(idp.ID()).
+			Delete().
+			SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't revoke cluster admin",
+				fmt.Sprintf(
+					"Can't delete identity provider '%s' of cluster '%s': %v",
+					idp.ID(), clusterID, err,
+				),
+			)
+			return
+		}
+	}
+
+	// Save the state:
+	state.ID = types.StringValue(clusterID)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterAdminRevocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get the current state:
+	state := &ClusterAdminRevocationState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterID := state.Cluster.ValueString()
+
+	// If the cluster is gone there is nothing to revoke anymore:
+	get, err := r.clusterCollection.Cluster(clusterID).Get().SendContext(ctx)
+	if err != nil {
+		if get.Status() == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("cluster (%s) not found, removing the admin revocation from state",
+				clusterID,
+			))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Can't find cluster",
+			fmt.Sprintf(
+				"Can't find cluster with identifier '%s': %v",
+				clusterID, err,
+			),
+		)
+		return
+	}
+
+	// If the admin has been created again it needs to be revoked again:
+	idp, err := r.findClusterAdminIDP(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't find cluster admin",
+			fmt.Sprintf(
+				"Can't find the identity provider of the admin of cluster '%s': %v",
+				clusterID, err,
+			),
+		)
+		return
+	}
+	if idp != nil {
+		tflog.Warn(ctx, fmt.Sprintf("admin of cluster (%s) exists again, removing the admin revocation from state",
+			clusterID,
+		))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterAdminRevocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the attributes require replacement, so this is never called with changes.
+	resp.Diagnostics.AddError("Can't update cluster admin revocation", "Update is currently not supported.")
+}
+
+func (r *ClusterAdminRevocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &ClusterAdminRevocationState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"Cannot restore cluster admin",
+		fmt.Sprintf(
+			"Cannot restore the admin of cluster '%s'. "+
+				"The revocation is being removed from the Terraform state only.",
+			state.Cluster.ValueString(),
+		),
+	)
+	// Remove the state:
+	resp.State.RemoveResource(ctx)
+}
+
+// findClusterAdminIDP returns the htpasswd identity provider of the admin of the cluster, or nil
+// if it doesn't exist.
+func (r *ClusterAdminRevocationResource) findClusterAdminIDP(ctx context.Context,
+	clusterID string) (*cmv1.IdentityProvider, error) {
+	list, err := r.clusterCollection.Cluster(clusterID).IdentityProviders().List().SendContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, idp := range list.Items().Slice() {
+		if idp.Name() == clusterAdminIDPName && idp.Type() == cmv1.IdentityProviderTypeHtpasswd {
+			return idp, nil
+		}
+	}
+	return nil, nil
+}
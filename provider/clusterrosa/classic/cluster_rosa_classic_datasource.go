@@ -144,6 +144,10 @@ func (r *ClusterRosaClassicDatasource) Schema(ctx context.Context, req datasourc
 				Description: "URL of the console.",
 				Computed:    true,
 			},
+			"oauth_url": schema.StringAttribute{
+				Description: "URL of the OAuth server. Empty until the cluster is ready.",
+				Computed:    true,
+			},
 			"aws_account_id": schema.StringAttribute{
 				Description: "Identifier of the AWS account. " + common.ValueCannotBeChangedStringDescription,
 				Computed:    true,
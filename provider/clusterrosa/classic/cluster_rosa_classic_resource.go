@@ -206,6 +206,10 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 				Description: "URL of the console.",
 				Computed:    true,
 			},
+			"oauth_url": schema.StringAttribute{
+				Description: "URL of the OAuth server. Empty until the cluster is ready.",
+				Computed:    true,
+			},
 			"domain": schema.StringAttribute{
 				Description: "DNS domain of cluster.",
 				Computed:    true,
@@ -632,6 +636,11 @@ func createClassicClusterObject(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if awsSubnetIDs != nil {
+		if err := ocmr.ValidateSubnetsCount(multiAZ, isPrivateLink, len(awsSubnetIDs)); err != nil {
+			return nil, err
+		}
+	}
 	awsAdditionalComputeSecurityGroupIds, err := common.StringListToArray(ctx, state.AWSAdditionalComputeSecurityGroupIds)
 	if err != nil {
 		return nil, err
@@ -875,6 +884,20 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 	}
 	object = add.Body()
 
+	// Skipping the cloud permission checks may result in a failed installation that is harder
+	// to diagnose, so let the user know:
+	if common.HasValue(state.DisableSCPChecks) && state.DisableSCPChecks.ValueBool() {
+		response.Diagnostics.AddWarning(
+			"Cloud permission checks are disabled",
+			fmt.Sprintf(
+				"The AWS permissions and service control policies weren't checked before "+
+					"creating cluster '%s'. If they don't allow the required actions the "+
+					"installation will fail",
+				state.Name.ValueString(),
+			),
+		)
+	}
+
 	// Save initial state:
 	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
 	if err != nil {
@@ -1511,6 +1534,7 @@ func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster,
 	}
 	state.APIURL = types.StringValue(object.API().URL())
 	state.ConsoleURL = types.StringValue(object.Console().URL())
+	state.OAuthURL = types.StringValue(common.OAuthURL(object))
 	state.Domain = types.StringValue(fmt.Sprintf("%s.%s", object.DomainPrefix(), object.DNS().BaseDomain()))
 	state.BaseDNSDomain = types.StringValue(object.DNS().BaseDomain())
 	state.InfraID = types.StringValue(object.InfraID())
@@ -45,6 +45,7 @@ type ClusterRosaClassicState struct {
 	DefaultMPLabels                           types.Map                    `tfsdk:"default_mp_labels"`
 	Replicas                                  types.Int64                  `tfsdk:"replicas"`
 	ConsoleURL                                types.String                 `tfsdk:"console_url"`
+	OAuthURL                                  types.String                 `tfsdk:"oauth_url"`
 	Domain                                    types.String                 `tfsdk:"domain"`
 	InfraID                                   types.String                 `tfsdk:"infra_id"`
 	HostPrefix                                types.Int64                  `tfsdk:"host_prefix"`
@@ -117,6 +117,10 @@ func (r *ClusterRosaHcpDatasource) Schema(ctx context.Context, req datasource.Sc
 				Description: "URL of the console.",
 				Computed:    true,
 			},
+			"oauth_url": schema.StringAttribute{
+				Description: "URL of the OAuth server. Empty until the cluster is ready.",
+				Computed:    true,
+			},
 			"domain": schema.StringAttribute{
 				Description: "DNS domain of cluster.",
 				Computed:    true,
@@ -176,6 +180,11 @@ func (r *ClusterRosaHcpDatasource) Schema(ctx context.Context, req datasource.Sc
 					listvalidator.ValueStringsAre(rosa.AvailabilityZoneValidator),
 				},
 			},
+			"subnet_az_mapping": schema.MapAttribute{
+				Description: deprecatedMessage,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"machine_cidr": schema.StringAttribute{
 				Description: "Block of IP addresses for nodes. " + common.ValueCannotBeChangedStringDescription,
 				Computed:    true,
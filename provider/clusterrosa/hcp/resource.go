@@ -176,6 +176,10 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 				Description: "URL of the console.",
 				Computed:    true,
 			},
+			"oauth_url": schema.StringAttribute{
+				Description: "URL of the OAuth server. Empty until the cluster is ready.",
+				Computed:    true,
+			},
 			"domain": schema.StringAttribute{
 				Description: "DNS domain of cluster.",
 				Computed:    true,
@@ -274,6 +278,16 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"subnet_az_mapping": schema.MapAttribute{
+				Description: "Availability zone of each subnet, for example `{\"subnet-1\" = \"us-east-1a\"}`. " +
+					"It is only used to validate the subnets before the creation of the cluster, it isn't sent to OCM. " +
+					"The subnets and zones must be part of `aws_subnet_ids` and `availability_zones`. " +
+					"A private cluster must have each subnet in a distinct availability zone, and a public cluster " +
+					"at most a public and a private subnet in each availability zone. " + common.ValueCannotBeChangedStringDescription,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.Map{subnetAZMappingValidator()},
+			},
 			"machine_cidr": schema.StringAttribute{
 				Description: "Block of IP addresses for nodes. " + common.ValueCannotBeChangedStringDescription,
 				Optional:    true,
@@ -839,6 +853,49 @@ func getOcmVersionMinor(ver string) string {
 	return fmt.Sprintf("%d.%d", segments[0], segments[1])
 }
 
+// subnetAZMappingValidator checks the subnet to availability zone mapping against the subnets,
+// the availability zones and the privacy of the cluster.
+func subnetAZMappingValidator() validator.Map {
+	return attrvalidators.NewMapValidator("subnet to availability zone mapping validator", func(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+		if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+			return
+		}
+		var subnetIDs, availabilityZones types.List
+		var private types.Bool
+		diags := req.Config.GetAttribute(ctx, path.Root("aws_subnet_ids"), &subnetIDs)
+		resp.Diagnostics.Append(diags...)
+		diags = req.Config.GetAttribute(ctx, path.Root("availability_zones"), &availabilityZones)
+		resp.Diagnostics.Append(diags...)
+		diags = req.Config.GetAttribute(ctx, path.Root("private"), &private)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The mapping can only be checked once all the values are known:
+		if subnetIDs.IsUnknown() || availabilityZones.IsUnknown() || private.IsUnknown() {
+			return
+		}
+		mapping, err := common.OptionalMap(ctx, req.ConfigValue)
+		if err != nil {
+			// Elements aren't known yet
+			return
+		}
+		subnetIDsArray, err := common.StringListToArray(ctx, subnetIDs)
+		if err != nil {
+			return
+		}
+		availabilityZonesArray, err := common.StringListToArray(ctx, availabilityZones)
+		if err != nil {
+			return
+		}
+		err = ocmr.ValidateSubnetAZMapping(mapping, subnetIDsArray, availabilityZonesArray,
+			common.BoolWithFalseDefault(private))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid subnet to availability zone mapping", err.Error())
+		}
+	})
+}
+
 func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.CreateRequest,
 	response *resource.CreateResponse) {
 	tflog.Debug(ctx, "begin create()")
@@ -1105,6 +1162,7 @@ func validateNoImmutableAttChange(state, plan *ClusterRosaHcpState) diag.Diagnos
 	common.ValidateStateAndPlanEquals(state.MaxReplicas, plan.MaxReplicas, "max_replicas", &diags)
 	common.ValidateStateAndPlanEquals(state.ComputeMachineType, plan.ComputeMachineType, "compute_machine_type", &diags)
 	common.ValidateStateAndPlanEquals(state.AvailabilityZones, plan.AvailabilityZones, "availability_zones", &diags)
+	common.ValidateStateAndPlanEquals(state.SubnetAZMapping, plan.SubnetAZMapping, "subnet_az_mapping", &diags)
 	common.ValidateStateAndPlanEquals(state.Ec2MetadataHttpTokens, plan.Ec2MetadataHttpTokens, "ec2_metadata_http_tokens", &diags)
 	common.ValidateStateAndPlanEquals(state.WorkerDiskSize, plan.WorkerDiskSize, "worker_disk_size", &diags)
 
@@ -1649,6 +1707,7 @@ func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, stat
 	}
 	state.APIURL = types.StringValue(object.API().URL())
 	state.ConsoleURL = types.StringValue(object.Console().URL())
+	state.OAuthURL = types.StringValue(common.OAuthURL(object))
 	state.Domain = types.StringValue(fmt.Sprintf("%s.%s", object.DomainPrefix(), object.DNS().BaseDomain()))
 	state.BaseDNSDomain = types.StringValue(object.DNS().BaseDomain())
 
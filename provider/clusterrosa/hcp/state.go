@@ -16,6 +16,7 @@ type ClusterRosaHcpState struct {
 	Private        types.Bool   `tfsdk:"private"`
 	APIURL         types.String `tfsdk:"api_url"`
 	ConsoleURL     types.String `tfsdk:"console_url"`
+	OAuthURL       types.String `tfsdk:"oauth_url"`
 	ChannelGroup   types.String `tfsdk:"channel_group"`
 	EtcdEncryption types.Bool   `tfsdk:"etcd_encryption"`
 	Properties     types.Map    `tfsdk:"properties"`
@@ -50,6 +51,7 @@ type ClusterRosaHcpState struct {
 	ComputeMachineType    types.String `tfsdk:"compute_machine_type"`
 	Replicas              types.Int64  `tfsdk:"replicas"`
 	AvailabilityZones     types.List   `tfsdk:"availability_zones"`
+	SubnetAZMapping       types.Map    `tfsdk:"subnet_az_mapping"`
 	Ec2MetadataHttpTokens types.String `tfsdk:"ec2_metadata_http_tokens"`
 	WorkerDiskSize        types.Int64  `tfsdk:"worker_disk_size"`
 
@@ -0,0 +1,29 @@
+package attrvalidators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type int64Validator struct {
+	desc      string
+	validator func(context.Context, validator.Int64Request, *validator.Int64Response)
+}
+
+func (v *int64Validator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	v.validator(ctx, req, resp)
+}
+func (v *int64Validator) Description(ctx context.Context) string {
+	return v.desc
+}
+func (v *int64Validator) MarkdownDescription(ctx context.Context) string {
+	return v.desc
+}
+
+func NewInt64Validator(desc string, validator func(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response)) validator.Int64 {
+	return &int64Validator{
+		desc:      desc,
+		validator: validator,
+	}
+}
@@ -165,3 +165,12 @@ func GetJsonStringOrNullString[T any](value *T) string {
 	}
 	return fmt.Sprintf("%+v", *value)
 }
+
+// PopulateSingleItem returns the only element of the given items, or nil if there are zero or
+// many of them. List data sources use it to fill the `item` convenience attribute.
+func PopulateSingleItem[T any](items []*T) *T {
+	if len(items) != 1 {
+		return nil
+	}
+	return items[0]
+}
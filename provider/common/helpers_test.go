@@ -50,4 +50,24 @@ var _ = Describe("Helper function tests", func() {
 			Expect(ok).ToNot(BeTrue())
 		})
 	})
+
+	Context("PopulateSingleItem", func() {
+		type item struct {
+			ID string
+		}
+
+		It("Should return the item when there is exactly one", func() {
+			aws := &item{ID: "aws"}
+			Expect(PopulateSingleItem([]*item{aws})).To(BeIdenticalTo(aws))
+		})
+
+		It("Should return nil when there are no items", func() {
+			Expect(PopulateSingleItem([]*item{})).To(BeNil())
+			Expect(PopulateSingleItem[item](nil)).To(BeNil())
+		})
+
+		It("Should return nil when there are multiple items", func() {
+			Expect(PopulateSingleItem([]*item{{ID: "aws"}, {ID: "gcp"}})).To(BeNil())
+		})
+	})
 })
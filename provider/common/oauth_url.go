@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/url"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	apiHostPrefix      = "api."
+	consoleHostPrefix  = "console-openshift-console."
+	hcpOAuthHostPrefix = "oauth."
+	oauthHostPrefix    = "oauth-openshift."
+)
+
+// OAuthURL returns the URL of the OAuth server of the cluster. OCM doesn't report it, so it is
+// calculated from the API URL for hosted control planes and from the console URL for the rest
+// of the clusters. The result is empty if the cluster isn't ready and those URLs aren't known yet.
+func OAuthURL(object *cmv1.Cluster) string {
+	if object.Hypershift().Enabled() {
+		return replaceHostPrefix(object.API().URL(), apiHostPrefix, hcpOAuthHostPrefix)
+	}
+	return replaceHostPrefix(object.Console().URL(), consoleHostPrefix, oauthHostPrefix)
+}
+
+// replaceHostPrefix replaces the prefix of the host of the given URL, keeping the port. The
+// result is empty if the URL is empty or its host doesn't have the expected prefix.
+func replaceHostPrefix(text, oldPrefix, newPrefix string) string {
+	if text == "" {
+		return ""
+	}
+	parsed, err := url.Parse(text)
+	if err != nil || !strings.HasPrefix(parsed.Host, oldPrefix) {
+		return ""
+	}
+	result := url.URL{
+		Scheme: parsed.Scheme,
+		Host:   newPrefix + strings.TrimPrefix(parsed.Host, oldPrefix),
+	}
+	return result.String()
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("OAuthURL", func() {
+	It("Should derive the URL from the console of classic clusters", func() {
+		cluster, err := cmv1.NewCluster().
+			API(cmv1.NewClusterAPI().URL("https://api.my-cluster.abcd.p1.openshiftapps.com:6443")).
+			Console(cmv1.NewClusterConsole().URL("https://console-openshift-console.apps.my-cluster.abcd.p1.openshiftapps.com")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(OAuthURL(cluster)).To(Equal("https://oauth-openshift.apps.my-cluster.abcd.p1.openshiftapps.com"))
+	})
+
+	It("Should derive the URL from the API of hosted control plane clusters", func() {
+		cluster, err := cmv1.NewCluster().
+			Hypershift(cmv1.NewHypershift().Enabled(true)).
+			API(cmv1.NewClusterAPI().URL("https://api.my-cluster.abcd.p3.openshiftapps.com:443")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(OAuthURL(cluster)).To(Equal("https://oauth.my-cluster.abcd.p3.openshiftapps.com:443"))
+	})
+
+	It("Should return empty while the cluster isn't ready", func() {
+		cluster, err := cmv1.NewCluster().Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(OAuthURL(cluster)).To(BeEmpty())
+	})
+
+	It("Should return empty if the URL doesn't have the expected host", func() {
+		cluster, err := cmv1.NewCluster().
+			Console(cmv1.NewClusterConsole().URL("https://my-console.example.com")).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(OAuthURL(cluster)).To(BeEmpty())
+	})
+})
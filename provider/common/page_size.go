@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MaxPageSize is the largest number of items that OCM returns in a single page.
+const MaxPageSize = 100
+
+// PageSizeAttribute returns the schema of the optional `page_size` attribute of the list data
+// sources.
+func PageSizeAttribute(defaultSize int) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("Number of items requested to OCM in each page of the list. "+
+			"Must be between 1 and %d. Defaults to %d.", MaxPageSize, defaultSize),
+		Optional:   true,
+		Validators: []validator.Int64{int64validator.Between(1, MaxPageSize)},
+	}
+}
+
+// PageSize returns the page size configured in the `page_size` attribute, or the given default
+// if it isn't set.
+func PageSize(value types.Int64, defaultSize int) int {
+	if !HasValue(value) {
+		return defaultSize
+	}
+	return int(value.ValueInt64())
+}
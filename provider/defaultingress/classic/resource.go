@@ -28,12 +28,8 @@ import (
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/defaultingress"
 )
 
-var validWildcardPolicies = []string{string(cmv1.WildcardPolicyWildcardsDisallowed),
-	string(cmv1.WildcardPolicyWildcardsAllowed)}
 var defaultWildcardPolicy = cmv1.WildcardPolicyWildcardsDisallowed
 
-var validNamespaceOwnershipPolicies = []string{string(cmv1.NamespaceOwnershipPolicyStrict),
-	string(cmv1.NamespaceOwnershipPolicyInterNamespaceAllowed)}
 var defaultNamespaceOwnershipPolicy = cmv1.NamespaceOwnershipPolicyStrict
 
 var validLbTypes = []string{string(cmv1.LoadBalancerFlavorClassic), string(cmv1.LoadBalancerFlavorNlb)}
@@ -96,17 +92,17 @@ func (r *DefaultIngressResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"route_wildcard_policy": schema.StringAttribute{
 				Description: fmt.Sprintf("Wildcard Policy for ingress. Options are %s. Default is '%s'.",
-					strings.Join(validWildcardPolicies, ","), defaultWildcardPolicy),
+					strings.Join(defaultingress.ValidWildcardPolicies, ","), defaultWildcardPolicy),
 				Optional:   true,
 				Computed:   true,
-				Validators: []validator.String{attrvalidators.EnumValueValidator(validWildcardPolicies)},
+				Validators: []validator.String{attrvalidators.EnumValueValidator(defaultingress.ValidWildcardPolicies)},
 			},
 			"route_namespace_ownership_policy": schema.StringAttribute{
 				Description: fmt.Sprintf("Namespace Ownership Policy for ingress. Options are %s. Default is '%s'.",
-					strings.Join(validNamespaceOwnershipPolicies, ","), defaultNamespaceOwnershipPolicy),
+					strings.Join(defaultingress.ValidNamespaceOwnershipPolicies, ","), defaultNamespaceOwnershipPolicy),
 				Optional:   true,
 				Computed:   true,
-				Validators: []validator.String{attrvalidators.EnumValueValidator(validNamespaceOwnershipPolicies)},
+				Validators: []validator.String{attrvalidators.EnumValueValidator(defaultingress.ValidNamespaceOwnershipPolicies)},
 			},
 			"cluster_routes_hostname": schema.StringAttribute{
 				Description: "Components route hostname for oauth, console, download.",
@@ -317,17 +313,7 @@ func (r *DefaultIngressResource) populateDefaultIngress(
 }
 
 func (r *DefaultIngressResource) populateDefaultIngressFromList(ctx context.Context, state *DefaultIngress) (*cmv1.Ingress, error) {
-	ingresses, err := r.collection.Cluster(state.Cluster.ValueString()).Ingresses().List().SendContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-	for _, ingress := range ingresses.Items().Slice() {
-		if ingress.Default() {
-			return ingress, nil
-		}
-	}
-
-	return nil, fmt.Errorf("failed to find default ingress")
+	return defaultingress.FindDefaultIngress(ctx, r.collection, state.Cluster.ValueString())
 }
 
 func (r *DefaultIngressResource) populateState(ingress *cmv1.Ingress, state *DefaultIngress) error {
@@ -473,28 +459,9 @@ func (r *DefaultIngressResource) updateIngress(ctx context.Context, state, plan
 
 func getDefaultIngressBuilder(ctx context.Context, state, plan *DefaultIngress) *cmv1.IngressBuilder {
 	ingressBuilder := cmv1.NewIngress()
-	if !reflect.DeepEqual(state.RouteSelectors, plan.RouteSelectors) {
-		routeSelectors, err := common.OptionalMap(ctx, plan.RouteSelectors)
-		if err != nil {
-			return nil
-		}
-		if routeSelectors == nil {
-			routeSelectors = map[string]string{}
-		}
-		ingressBuilder.RouteSelectors(routeSelectors)
-	}
-	if !reflect.DeepEqual(state.ExcludedNamespaces, plan.ExcludedNamespaces) {
-		excludedNamespace := common.OptionalList(plan.ExcludedNamespaces)
-		ingressBuilder.ExcludedNamespaces(excludedNamespace...)
-	}
-
-	// wildcard policy can't be empty
-	if !common.IsStringAttributeUnknownOrEmpty(plan.WildcardPolicy) && state.WildcardPolicy != plan.WildcardPolicy {
-		ingressBuilder.RouteWildcardPolicy(cmv1.WildcardPolicy(plan.WildcardPolicy.ValueString()))
-	}
-	// NamespaceOwnershipPolicy can't be empty
-	if !common.IsStringAttributeUnknownOrEmpty(plan.NamespaceOwnershipPolicy) && state.NamespaceOwnershipPolicy != plan.NamespaceOwnershipPolicy {
-		ingressBuilder.RouteNamespaceOwnershipPolicy(cmv1.NamespaceOwnershipPolicy(plan.NamespaceOwnershipPolicy.ValueString()))
+	_, err := defaultingress.AddRouteSettings(ctx, ingressBuilder, routeSettings(state), routeSettings(plan))
+	if err != nil {
+		return nil
 	}
 	// LoadBalancer type can't be empty
 	if !common.IsStringAttributeUnknownOrEmpty(plan.LoadBalancerType) && state.LoadBalancerType != plan.LoadBalancerType {
@@ -503,6 +470,15 @@ func getDefaultIngressBuilder(ctx context.Context, state, plan *DefaultIngress)
 	return ingressBuilder
 }
 
+func routeSettings(state *DefaultIngress) defaultingress.RouteSettings {
+	return defaultingress.RouteSettings{
+		RouteSelectors:           state.RouteSelectors,
+		ExcludedNamespaces:       state.ExcludedNamespaces,
+		WildcardPolicy:           state.WildcardPolicy,
+		NamespaceOwnershipPolicy: state.NamespaceOwnershipPolicy,
+	}
+}
+
 func validateDefaultIngress(ctx context.Context, state *DefaultIngress, diags diag.Diagnostics) error {
 	if common.IsStringAttributeUnknownOrEmpty(state.ClusterRoutesHostname) != common.IsStringAttributeUnknownOrEmpty(state.ClusterRoutesTlsSecretRef) {
 		msg := fmt.Sprint("default_ingress params: cluster_routes_hostname and cluster_routes_tls_secret_ref must be set together")
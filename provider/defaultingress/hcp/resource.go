@@ -21,6 +21,7 @@ import (
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/defaultingress"
 )
 
 var validListeningMethods = []string{string(cmv1.ListeningMethodExternal), string(cmv1.ListeningMethodInternal)}
@@ -253,17 +254,7 @@ func (r *DefaultIngressResource) populateDefaultIngress(
 }
 
 func (r *DefaultIngressResource) populateDefaultIngressFromList(ctx context.Context, state *DefaultIngress) (*cmv1.Ingress, error) {
-	ingresses, err := r.collection.Cluster(state.Cluster.ValueString()).Ingresses().List().SendContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-	for _, ingress := range ingresses.Items().Slice() {
-		if ingress.Default() {
-			return ingress, nil
-		}
-	}
-
-	return nil, fmt.Errorf("failed to find default ingress")
+	return defaultingress.FindDefaultIngress(ctx, r.collection, state.Cluster.ValueString())
 }
 
 func (r *DefaultIngressResource) populateState(ingress *cmv1.Ingress, state *DefaultIngress) error {
@@ -0,0 +1,81 @@
+package defaultingress
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+var ValidWildcardPolicies = []string{string(cmv1.WildcardPolicyWildcardsDisallowed),
+	string(cmv1.WildcardPolicyWildcardsAllowed)}
+
+var ValidNamespaceOwnershipPolicies = []string{string(cmv1.NamespaceOwnershipPolicyStrict),
+	string(cmv1.NamespaceOwnershipPolicyInterNamespaceAllowed)}
+
+// ErrNotFound is returned by FindDefaultIngress when the cluster has no default ingress.
+var ErrNotFound = fmt.Errorf("failed to find default ingress")
+
+// RouteSettings are the route admission settings of a default ingress, shared by the resources
+// that manage it.
+type RouteSettings struct {
+	RouteSelectors           types.Map
+	ExcludedNamespaces       types.List
+	WildcardPolicy           types.String
+	NamespaceOwnershipPolicy types.String
+}
+
+// FindDefaultIngress returns the default ingress of the given cluster.
+func FindDefaultIngress(ctx context.Context, collection *cmv1.ClustersClient,
+	clusterID string) (*cmv1.Ingress, error) {
+	ingresses, err := collection.Cluster(clusterID).Ingresses().List().SendContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ingress := range ingresses.Items().Slice() {
+		if ingress.Default() {
+			return ingress, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// AddRouteSettings adds to the builder the route settings of the plan that differ from the
+// state, and reports if any was added.
+func AddRouteSettings(ctx context.Context, ingressBuilder *cmv1.IngressBuilder,
+	state, plan RouteSettings) (bool, error) {
+	changed := false
+	if !reflect.DeepEqual(state.RouteSelectors, plan.RouteSelectors) {
+		routeSelectors, err := common.OptionalMap(ctx, plan.RouteSelectors)
+		if err != nil {
+			return false, err
+		}
+		if routeSelectors == nil {
+			routeSelectors = map[string]string{}
+		}
+		ingressBuilder.RouteSelectors(routeSelectors)
+		changed = true
+	}
+	if !reflect.DeepEqual(state.ExcludedNamespaces, plan.ExcludedNamespaces) {
+		excludedNamespace := common.OptionalList(plan.ExcludedNamespaces)
+		ingressBuilder.ExcludedNamespaces(excludedNamespace...)
+		changed = true
+	}
+
+	// wildcard policy can't be empty
+	if !common.IsStringAttributeUnknownOrEmpty(plan.WildcardPolicy) && state.WildcardPolicy != plan.WildcardPolicy {
+		ingressBuilder.RouteWildcardPolicy(cmv1.WildcardPolicy(plan.WildcardPolicy.ValueString()))
+		changed = true
+	}
+	// NamespaceOwnershipPolicy can't be empty
+	if !common.IsStringAttributeUnknownOrEmpty(plan.NamespaceOwnershipPolicy) && state.NamespaceOwnershipPolicy != plan.NamespaceOwnershipPolicy {
+		ingressBuilder.RouteNamespaceOwnershipPolicy(cmv1.NamespaceOwnershipPolicy(plan.NamespaceOwnershipPolicy.ValueString()))
+		changed = true
+	}
+	return changed, nil
+}
@@ -27,8 +27,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
+// defaultPageSize is the number of groups requested in each page when the `page_size` attribute
+// isn't set.
+const defaultPageSize = 10
+
 type GroupsDataSource struct {
 	collection *cmv1.ClustersClient
 }
@@ -55,6 +61,7 @@ func (g *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
 				},
 			},
+			"page_size": common.PageSizeAttribute(defaultPageSize),
 			"items": schema.ListNestedAttribute{
 				Description: "Content of the list.",
 				NestedObject: schema.NestedAttributeObject{
@@ -109,9 +116,9 @@ func (g *GroupsDataSource) Read(ctx context.Context, request datasource.ReadRequ
 
 	// Fetch the complete list of groups of the cluster:
 	var listItems []*cmv1.Group
-	listSize := 10
+	listSize := common.PageSize(state.PageSize, defaultPageSize)
 	listPage := 1
-	listRequest := g.collection.Cluster(state.Cluster.ValueString()).Groups().List()
+	listRequest := g.collection.Cluster(state.Cluster.ValueString()).Groups().List().Size(listSize)
 	for {
 		listResponse, err := listRequest.SendContext(ctx)
 		if err != nil {
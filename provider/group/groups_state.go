@@ -21,6 +21,7 @@ import (
 )
 
 type GroupsState struct {
-	Cluster types.String  `tfsdk:"cluster"`
-	Items   []*GroupState `tfsdk:"items"`
+	Cluster  types.String  `tfsdk:"cluster"`
+	PageSize types.Int64   `tfsdk:"page_size"`
+	Items    []*GroupState `tfsdk:"items"`
 }
@@ -650,12 +650,12 @@ func (r *IdentityProviderResource) Delete(ctx context.Context, request resource.
 
 func (r *IdentityProviderResource) ImportState(ctx context.Context, request resource.ImportStateRequest,
 	response *resource.ImportStateResponse) {
-	// To import an identity provider, we need to know the cluster ID and the provider name.
+	// To import an identity provider, we need to know the cluster ID and the provider ID or name.
 	fields := strings.Split(request.ID, ",")
 	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
 		response.Diagnostics.AddError(
 			"Invalid import identifier",
-			"Identity provider to import should be specified as <cluster_id>,<provider_name>",
+			"Identity provider to import should be specified as <cluster_id>,<provider_id> or <cluster_id>,<provider_name>",
 		)
 		return
 	}
@@ -688,7 +688,8 @@ func (r *IdentityProviderResource) ImportState(ctx context.Context, request reso
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), providerID)...)
 }
 
-// getIDPIDFromName returns the ID of the identity provider with the given name.
+// getIDPIDFromName returns the ID of the identity provider with the given name. The name can also
+// be the ID of the identity provider, in which case it is returned as is.
 func getIDPIDFromName(ctx context.Context, client *cmv1.ClusterClient, name string) (string, error) {
 	tflog.Debug(ctx, "Converting IDP name to ID", map[string]interface{}{"name": name})
 	// Get the list of identity providers for the cluster:
@@ -711,9 +712,9 @@ func getIDPIDFromName(ctx context.Context, client *cmv1.ClusterClient, name stri
 		page++
 	}
 
-	// Find the identity provider with the given name
+	// Find the identity provider with the given ID or name
 	for _, item := range identityProviders {
-		if item.Name() == name {
+		if item.ID() == name || item.Name() == name {
 			id := item.ID()
 			tflog.Debug(ctx, "Found IDP", map[string]interface{}{"name": name, "id": id})
 			return id, nil
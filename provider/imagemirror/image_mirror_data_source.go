@@ -110,23 +110,41 @@ func (d *ImageMirrorDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	clusterId := data.ClusterID.ValueString()
 
-	// Get all image mirrors for the cluster
-	response, err := d.clustersClient.Cluster(clusterId).ImageMirrors().List().SendContext(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to List Image Mirrors",
-			fmt.Sprintf("Could not list image mirrors for cluster '%s': %s", clusterId, err.Error()),
-		)
-		return
+	// Get all image mirrors for the cluster, page by page
+	var listItems []*cmv1.ImageMirror
+	listSize := 100
+	listPage := 1
+	listRequest := d.clustersClient.Cluster(clusterId).ImageMirrors().List().Size(listSize)
+	for {
+		response, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to List Image Mirrors",
+				fmt.Sprintf("Could not list image mirrors for cluster '%s': %s", clusterId, err.Error()),
+			)
+			return
+		}
+		if listItems == nil {
+			listItems = make([]*cmv1.ImageMirror, 0, response.Total())
+		}
+		response.Items().Each(func(imageMirror *cmv1.ImageMirror) bool {
+			listItems = append(listItems, imageMirror)
+			return true
+		})
+		if response.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
 	}
 
 	// Convert OCM image mirrors to our model
-	imageMirrors := make([]ImageMirrorModel, 0, response.Size())
-	response.Items().Each(func(imageMirror *cmv1.ImageMirror) bool {
+	imageMirrors := make([]ImageMirrorModel, 0, len(listItems))
+	for _, imageMirror := range listItems {
 		mirrors, diags := types.ListValueFrom(ctx, types.StringType, imageMirror.Mirrors())
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
-			return false
+			return
 		}
 
 		model := ImageMirrorModel{
@@ -144,8 +162,7 @@ func (d *ImageMirrorDataSource) Read(ctx context.Context, req datasource.ReadReq
 		}
 
 		imageMirrors = append(imageMirrors, model)
-		return true
-	})
+	}
 
 	data.ImageMirrors = imageMirrors
 
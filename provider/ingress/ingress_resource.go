@@ -0,0 +1,399 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common/attrvalidators"
+)
+
+var validListeningMethods = []string{string(cmv1.ListeningMethodExternal), string(cmv1.ListeningMethodInternal)}
+
+var validLbTypes = []string{string(cmv1.LoadBalancerFlavorClassic), string(cmv1.LoadBalancerFlavorNlb)}
+
+type IngressResource struct {
+	collection  *cmv1.ClustersClient
+	clusterWait common.ClusterWait
+}
+
+var _ resource.ResourceWithConfigure = &IngressResource{}
+var _ resource.ResourceWithImportState = &IngressResource{}
+
+func New() resource.Resource {
+	return &IngressResource{}
+}
+
+func (r *IngressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingress"
+}
+
+func (r *IngressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an additional (non default) ingress of a cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the ingress.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					// This passes the state through to the plan, preventing
+					// "known after apply" since we know it won't change.
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"listening": schema.StringAttribute{
+				Description: fmt.Sprintf("Listening method of the ingress. Options are %s.",
+					strings.Join(validListeningMethods, ",")),
+				Required:   true,
+				Validators: []validator.String{attrvalidators.EnumValueValidator(validListeningMethods)},
+			},
+			"route_selectors": schema.MapAttribute{
+				Description: "Route selectors of the ingress. Only the routes matching these labels " +
+					"are exposed on this ingress.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.Map{attrvalidators.NotEmptyMapValidator()},
+			},
+			"load_balancer_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of Load Balancer. Options are %s.", strings.Join(validLbTypes, ",")),
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{attrvalidators.EnumValueValidator(validLbTypes)},
+			},
+			"dns_name": schema.StringAttribute{
+				Description: "DNS name of the ingress.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	return
+}
+
+func (r *IngressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connection, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+	r.clusterWait = common.NewClusterWait(r.collection, connection)
+}
+
+func (r *IngressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Get the plan:
+	state := &IngressState{}
+	diags := req.Plan.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait till the cluster is ready:
+	waitTimeoutInMinutes := int64(60)
+	_, err := r.clusterWait.WaitForClusterToBeReady(ctx, state.Cluster.ValueString(), waitTimeoutInMinutes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't poll cluster state",
+			fmt.Sprintf(
+				"Can't poll state of cluster with identifier '%s': %v",
+				state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	// Create the ingress:
+	builder := cmv1.NewIngress().
+		Default(false).
+		Listening(cmv1.ListeningMethod(state.Listening.ValueString()))
+	routeSelectors, err := common.OptionalMap(ctx, state.RouteSelectors)
+	if err != nil {
+		resp.Diagnostics.AddError("Can't build ingress", err.Error())
+		return
+	}
+	if routeSelectors != nil {
+		builder.RouteSelectors(routeSelectors)
+	}
+	if common.HasValue(state.LoadBalancerType) {
+		builder.LoadBalancerType(cmv1.LoadBalancerFlavor(state.LoadBalancerType.ValueString()))
+	}
+	object, err := builder.Build()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't build ingress",
+			fmt.Sprintf(
+				"Can't build ingress for cluster '%s': %v",
+				state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+	add, err := r.collection.Cluster(state.Cluster.ValueString()).Ingresses().Add().Body(object).SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't create ingress",
+			fmt.Sprintf(
+				"Can't create ingress for cluster '%s': %v",
+				state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	// Save the state:
+	err = populateState(add.Body(), state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't populate ingress state",
+			fmt.Sprintf(
+				"Received error %v", err,
+			),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *IngressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get the current state:
+	state := &IngressState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find the ingress:
+	get, err := r.collection.Cluster(state.Cluster.ValueString()).Ingresses().
+		Ingress(state.ID.ValueString()).
+		Get().
+		SendContext(ctx)
+	if err != nil {
+		if get.Status() == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("ingress (%s) of cluster (%s) not found, removing from state",
+				state.ID.ValueString(), state.Cluster.ValueString(),
+			))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Can't find ingress",
+			fmt.Sprintf(
+				"Can't find ingress with identifier '%s' for cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	// Save the state:
+	err = populateState(get.Body(), state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't populate ingress state",
+			fmt.Sprintf(
+				"Received error %v", err,
+			),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *IngressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get the state:
+	state := &IngressState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the plan:
+	plan := &IngressState{}
+	diags = req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send only the attributes that changed:
+	builder := cmv1.NewIngress()
+	listening, ok := common.ShouldPatchString(state.Listening, plan.Listening)
+	if ok {
+		builder.Listening(cmv1.ListeningMethod(listening))
+	}
+	if !plan.RouteSelectors.Equal(state.RouteSelectors) {
+		routeSelectors, err := common.OptionalMap(ctx, plan.RouteSelectors)
+		if err != nil {
+			resp.Diagnostics.AddError("Can't build ingress patch", err.Error())
+			return
+		}
+		if routeSelectors == nil {
+			routeSelectors = map[string]string{}
+		}
+		builder.RouteSelectors(routeSelectors)
+	}
+	loadBalancerType, ok := common.ShouldPatchString(state.LoadBalancerType, plan.LoadBalancerType)
+	if ok {
+		builder.LoadBalancerType(cmv1.LoadBalancerFlavor(loadBalancerType))
+	}
+	patch, err := builder.Build()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't build ingress patch",
+			fmt.Sprintf(
+				"Can't build patch for ingress with identifier '%s' of cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+	update, err := r.collection.Cluster(state.Cluster.ValueString()).Ingresses().
+		Ingress(state.ID.ValueString()).
+		Update().
+		Body(patch).
+		SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't update ingress",
+			fmt.Sprintf(
+				"Can't update ingress with identifier '%s' of cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	// Save the state:
+	err = populateState(update.Body(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't populate ingress state",
+			fmt.Sprintf(
+				"Received error %v", err,
+			),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *IngressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get the state:
+	state := &IngressState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send the request to delete the ingress:
+	response, err := r.collection.Cluster(state.Cluster.ValueString()).Ingresses().
+		Ingress(state.ID.ValueString()).
+		Delete().
+		SendContext(ctx)
+	if err != nil && response.Status() != http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Can't delete ingress",
+			fmt.Sprintf(
+				"Can't delete ingress with identifier '%s' of cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	// Remove the state:
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IngressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// To import an ingress, we need to know the cluster ID and the ingress ID.
+	fields := strings.Split(req.ID, ",")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"Ingress to import should be specified as <cluster_id>,<ingress_id>",
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), fields[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fields[1])...)
+}
+
+// populateState copies the data from the API object to the Terraform state.
+func populateState(object *cmv1.Ingress, state *IngressState) error {
+	state.ID = types.StringValue(object.ID())
+	state.Listening = types.StringValue(string(object.Listening()))
+	state.LoadBalancerType = types.StringValue(string(object.LoadBalancerType()))
+	state.DNSName = types.StringValue(object.DNSName())
+
+	routeSelectors, ok := object.GetRouteSelectors()
+	if ok && len(routeSelectors) > 0 {
+		mapValue, err := common.ConvertStringMapToMapType(routeSelectors)
+		if err != nil {
+			return err
+		}
+		state.RouteSelectors = mapValue
+	} else {
+		state.RouteSelectors = types.MapNull(types.StringType)
+	}
+	return nil
+}
@@ -156,20 +156,36 @@ func (s *LogForwardersDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	clusterId := state.Cluster.ValueString()
 
-	logForwardersClient := s.collection.Cluster(clusterId).ControlPlane().LogForwarders()
-	listResponse, err := logForwardersClient.List().SendContext(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Can't list log forwarders",
-			fmt.Sprintf("Can't list log forwarders for cluster '%s': %v", clusterId, err),
-		)
-		return
+	// Fetch the complete list of log forwarders of the cluster:
+	var listItems []*cmv1.LogForwarder
+	listSize := 100
+	listPage := 1
+	listRequest := s.collection.Cluster(clusterId).ControlPlane().LogForwarders().List().Size(listSize)
+	for {
+		listResponse, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't list log forwarders",
+				fmt.Sprintf("Can't list log forwarders for cluster '%s': %v", clusterId, err),
+			)
+			return
+		}
+		if listItems == nil {
+			listItems = make([]*cmv1.LogForwarder, 0, listResponse.Total())
+		}
+		listResponse.Items().Each(func(listItem *cmv1.LogForwarder) bool {
+			listItems = append(listItems, listItem)
+			return true
+		})
+		if listResponse.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
 	}
 
-	logForwardersList := listResponse.Items()
-	state.Items = make([]*LogForwarderItem, 0, logForwardersList.Len())
-
-	logForwardersList.Each(func(logForwarder *cmv1.LogForwarder) bool {
+	state.Items = make([]*LogForwarderItem, 0, len(listItems))
+	for _, logForwarder := range listItems {
 		lfState := &LogForwarderItem{
 			ID:        types.StringValue(logForwarder.ID()),
 			ClusterID: types.StringValue(clusterId),
@@ -212,8 +228,7 @@ func (s *LogForwardersDataSource) Read(ctx context.Context, req datasource.ReadR
 		}
 
 		state.Items = append(state.Items, lfState)
-		return true
-	})
+	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
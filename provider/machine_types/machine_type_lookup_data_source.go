@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine_types
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const defaultCloudProvider = "aws"
+
+type MachineTypeLookupDataSource struct {
+	collection *cmv1.MachineTypesClient
+}
+
+var _ datasource.DataSource = &MachineTypeLookupDataSource{}
+var _ datasource.DataSourceWithConfigure = &MachineTypeLookupDataSource{}
+
+func NewLookup() datasource.DataSource {
+	return &MachineTypeLookupDataSource{}
+}
+
+func (s *MachineTypeLookupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_type_lookup"
+}
+
+func (s *MachineTypeLookupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Smallest machine type that satisfies the given CPU and RAM requirements.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: fmt.Sprintf("Unique identifier of the cloud provider where the machine "+
+					"type must be supported. Default value is '%s'.", defaultCloudProvider),
+				Optional: true,
+			},
+			"min_cpu": schema.Int64Attribute{
+				Description: "Minimum number of vCPU cores.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"min_ram": schema.Int64Attribute{
+				Description: "Minimum amount of RAM in bytes.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the selected machine type.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Short name of the selected machine type.",
+				Computed:    true,
+			},
+			"cpu": schema.Int64Attribute{
+				Description: "Number of vCPU cores of the selected machine type.",
+				Computed:    true,
+			},
+			"ram": schema.Int64Attribute{
+				Description: "Amount of RAM in bytes of the selected machine type.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (s *MachineTypeLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*sdk.Connection)
+
+	// Get the collection of machine types:
+	s.collection = connection.ClustersMgmt().V1().MachineTypes()
+}
+
+func (s *MachineTypeLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &MachineTypeLookupState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the complete list of machine types:
+	items, err := listMachineTypes(ctx, s.collection, defaultPageSize)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't list machine types",
+			err.Error(),
+		)
+		return
+	}
+
+	// Select the smallest machine type that satisfies the requirements:
+	cloudProvider := defaultCloudProvider
+	if !state.CloudProvider.IsNull() && !state.CloudProvider.IsUnknown() {
+		cloudProvider = state.CloudProvider.ValueString()
+	}
+	match := selectMachineType(items, cloudProvider, state.MinCPU.ValueInt64(), state.MinRAM.ValueInt64())
+	if match == nil {
+		resp.Diagnostics.AddError(
+			"Can't find machine type",
+			fmt.Sprintf(
+				"There is no machine type in cloud provider '%s' with at least %d vCPUs and %d bytes of RAM",
+				cloudProvider, state.MinCPU.ValueInt64(), state.MinRAM.ValueInt64(),
+			),
+		)
+		return
+	}
+
+	// Populate the state:
+	state.ID = types.StringValue(match.ID)
+	state.Name = types.StringValue(match.Name)
+	state.CPU = types.Int64Value(match.CPU)
+	state.RAM = types.Int64Value(match.RAM)
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// selectMachineType returns the machine type of the given cloud provider with the least CPU, and
+// then the least RAM, that has at least the given CPU and RAM. Ties are broken by identifier so
+// that the result is stable. It returns nil if no machine type matches.
+func selectMachineType(items []*MachineTypeState, cloudProvider string, minCPU, minRAM int64) *MachineTypeState {
+	var candidates []*MachineTypeState
+	for _, item := range items {
+		if item.CloudProvider == cloudProvider && item.CPU >= minCPU && item.RAM >= minRAM {
+			candidates = append(candidates, item)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].CPU != candidates[j].CPU {
+			return candidates[i].CPU < candidates[j].CPU
+		}
+		if candidates[i].RAM != candidates[j].RAM {
+			return candidates[i].RAM < candidates[j].RAM
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+	return candidates[0]
+}
@@ -26,8 +26,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
+// defaultPageSize is the number of machine types requested in each page when the `page_size`
+// attribute isn't set.
+const defaultPageSize = 10
+
 type MachineTypesDataSource struct {
 	collection *cmv1.MachineTypesClient
 }
@@ -47,6 +53,7 @@ func (s *MachineTypesDataSource) Schema(ctx context.Context, req datasource.Sche
 	resp.Schema = schema.Schema{
 		Description: "List of machine types",
 		Attributes: map[string]schema.Attribute{
+			"page_size": common.PageSizeAttribute(defaultPageSize),
 			"items": schema.ListNestedAttribute{
 				Description: "Items of the list.",
 				NestedObject: schema.NestedAttributeObject{
@@ -93,19 +100,43 @@ func (s *MachineTypesDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (s *MachineTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &MachineTypesState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Fetch the complete list of machine types:
+	items, err := listMachineTypes(ctx, s.collection, common.PageSize(state.PageSize, defaultPageSize))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't list machine types",
+			err.Error(),
+		)
+		return
+	}
+
+	// Populate the state:
+	state.Items = items
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// listMachineTypes fetches the complete list of machine types, with the CPU in vCPUs and the RAM
+// in bytes. The machine types are requested in pages of the given size.
+func listMachineTypes(ctx context.Context, collection *cmv1.MachineTypesClient,
+	listSize int) ([]*MachineTypeState, error) {
 	var listItems []*cmv1.MachineType
-	listSize := 10
 	listPage := 1
-	listRequest := s.collection.List().Size(listSize)
+	listRequest := collection.List().Size(listSize)
 	for {
 		listResponse, err := listRequest.SendContext(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Can't list machine types",
-				err.Error(),
-			)
-			return
+			return nil, err
 		}
 		if listItems == nil {
 			listItems = make([]*cmv1.MachineType, 0, listResponse.Total())
@@ -121,10 +152,7 @@ func (s *MachineTypesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		listRequest.Page(listPage)
 	}
 
-	// Populate the state:
-	state := &MachineTypesState{
-		Items: make([]*MachineTypeState, len(listItems)),
-	}
+	items := make([]*MachineTypeState, len(listItems))
 	for i, listItem := range listItems {
 		cpuObject := listItem.CPU()
 		cpuValue := cpuObject.Value()
@@ -133,11 +161,7 @@ func (s *MachineTypesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		case "vCPU":
 			// Nothing.
 		default:
-			resp.Diagnostics.AddError(
-				"Unknown CPU unit",
-				fmt.Sprintf("Don't know how to convert CPU unit '%s'", cpuUnit),
-			)
-			return
+			return nil, fmt.Errorf("don't know how to convert CPU unit '%s'", cpuUnit)
 		}
 		ramObject := listItem.Memory()
 		ramValue := ramObject.Value()
@@ -166,13 +190,9 @@ func (s *MachineTypesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		case "pib":
 			ramValue *= math.Pow(2, 50)
 		default:
-			resp.Diagnostics.AddError(
-				"Unknown RAM unit",
-				fmt.Sprintf("Don't know how to convert RAM unit '%s'", ramUnit),
-			)
-			return
+			return nil, fmt.Errorf("don't know how to convert RAM unit '%s'", ramUnit)
 		}
-		state.Items[i] = &MachineTypeState{
+		items[i] = &MachineTypeState{
 			CloudProvider: listItem.CloudProvider().ID(),
 			ID:            listItem.ID(),
 			Name:          listItem.Name(),
@@ -180,8 +200,5 @@ func (s *MachineTypesDataSource) Read(ctx context.Context, req datasource.ReadRe
 			RAM:           int64(ramValue),
 		}
 	}
-
-	// Save the state:
-	diags := resp.State.Set(ctx, state)
-	resp.Diagnostics.Append(diags...)
+	return items, nil
 }
@@ -16,8 +16,11 @@ limitations under the License.
 
 package machine_types
 
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
 type MachineTypesState struct {
-	Items []*MachineTypeState `tfsdk:"items"`
+	PageSize types.Int64         `tfsdk:"page_size"`
+	Items    []*MachineTypeState `tfsdk:"items"`
 }
 
 type MachineTypeState struct {
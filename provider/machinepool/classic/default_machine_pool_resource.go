@@ -0,0 +1,360 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+// DefaultMachinePoolResource manages the machine pool created along with a classic cluster. The
+// pool is adopted on creation and only released from the state on deletion, as the cluster
+// can't exist without it.
+type DefaultMachinePoolResource struct {
+	clusterCollection *cmv1.ClustersClient
+	clusterWait       common.ClusterWait
+}
+
+var _ resource.ResourceWithConfigure = &DefaultMachinePoolResource{}
+var _ resource.ResourceWithImportState = &DefaultMachinePoolResource{}
+
+func NewDefaultMachinePool() resource.Resource {
+	return &DefaultMachinePoolResource{}
+}
+
+func (r *DefaultMachinePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_machine_pool"
+}
+
+func (r *DefaultMachinePoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Edit the default machine pool of a ROSA Classic cluster. " +
+			"The machine pool can't be deleted, destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster. " + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the machine pool.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"replicas": schema.Int64Attribute{
+				Description: "The number of machines of the pool. If not set, the current number is kept.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"autoscaling_enabled": schema.BoolAttribute{
+				Description: "Enables autoscaling. If `true`, this variable requires you to set a maximum and minimum replicas range using the `max_replicas` and `min_replicas` variables.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"min_replicas": schema.Int64Attribute{
+				Description: "The minimum number of replicas for autoscaling functionality.",
+				Optional:    true,
+			},
+			"max_replicas": schema.Int64Attribute{
+				Description: "The maximum number of replicas for autoscaling functionality.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels for the machine pool. Format should be a comma-separated list of 'key = value'." +
+					" This list will overwrite any modifications made to node labels on an ongoing basis.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *DefaultMachinePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connection, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clusterCollection = connection.ClustersMgmt().V1().Clusters()
+	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection)
+}
+
+func (r *DefaultMachinePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Get the plan:
+	plan := &DefaultMachinePoolState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait till the cluster is ready:
+	waitTimeoutInMinutes := int64(60)
+	_, err := r.clusterWait.WaitForClusterToBeReady(ctx, plan.Cluster.ValueString(), waitTimeoutInMinutes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot poll cluster state",
+			fmt.Sprintf(
+				"Cannot poll state of cluster with identifier '%s': %v",
+				plan.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	// The default machine pool is created along with the cluster, so it is adopted and
+	// updated to match the plan:
+	plan.ID = types.StringValue(defaultMachinePoolName)
+	r.update(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DefaultMachinePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get the current state:
+	state := &DefaultMachinePoolState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	get, err := r.clusterCollection.Cluster(state.Cluster.ValueString()).
+		MachinePools().
+		MachinePool(state.ID.ValueString()).
+		Get().
+		SendContext(ctx)
+	if err != nil {
+		if get.Status() == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("default machine pool of cluster (%s) not found, removing from state",
+				state.Cluster.ValueString(),
+			))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Cannot find default machine pool",
+			fmt.Sprintf(
+				"Cannot find default machine pool of cluster '%s': %v",
+				state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	err = populateDefaultMachinePoolState(get.Body(), state)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot populate default machine pool state", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *DefaultMachinePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get the state:
+	state := &DefaultMachinePoolState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the plan:
+	plan := &DefaultMachinePoolState{}
+	diags = req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	common.ValidateStateAndPlanEquals(state.Cluster, plan.Cluster, "cluster", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	r.update(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DefaultMachinePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &DefaultMachinePoolState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"Cannot delete default machine pool",
+		fmt.Sprintf(
+			"Cannot delete the default machine pool of cluster '%s'. "+
+				"It is being removed from the Terraform state only. "+
+				"To resume managing it, import it again. "+
+				"It will be automatically deleted when the cluster is deleted.",
+			state.Cluster.ValueString(),
+		),
+	)
+	// Remove the state:
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *DefaultMachinePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The default machine pool is imported with the cluster ID only:
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), defaultMachinePoolName)...)
+}
+
+// update sends the replicas, autoscaling and labels of the plan to the default machine pool and
+// copies the result back to the plan.
+func (r *DefaultMachinePoolResource) update(ctx context.Context, plan *DefaultMachinePoolState, diags *diag.Diagnostics) {
+	resource := r.clusterCollection.Cluster(plan.Cluster.ValueString()).
+		MachinePools().
+		MachinePool(plan.ID.ValueString())
+	get, err := resource.Get().SendContext(ctx)
+	if err != nil {
+		diags.AddError(
+			"Cannot find default machine pool",
+			fmt.Sprintf(
+				"Cannot find default machine pool of cluster '%s': %v",
+				plan.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+	current := get.Body()
+
+	builder := cmv1.NewMachinePool().ID(plan.ID.ValueString())
+	autoscalingEnabled, errMsg := getAutoscaling(&MachinePoolState{
+		AutoScalingEnabled: plan.AutoScalingEnabled,
+		MinReplicas:        plan.MinReplicas,
+		MaxReplicas:        plan.MaxReplicas,
+	}, builder)
+	if errMsg != "" {
+		diags.AddError("Cannot update default machine pool", errMsg)
+		return
+	}
+	if autoscalingEnabled {
+		if common.HasValue(plan.Replicas) {
+			diags.AddError("Cannot update default machine pool",
+				"when enabling autoscaling, cannot set replicas")
+			return
+		}
+	} else if common.HasValue(plan.Replicas) {
+		builder.Replicas(int(plan.Replicas.ValueInt64()))
+	} else if _, ok := current.GetAutoscaling(); ok {
+		diags.AddError("Cannot update default machine pool",
+			"when disabling autoscaling, should set value for replicas")
+		return
+	} else {
+		builder.Replicas(current.Replicas())
+	}
+
+	labels, err := common.OptionalMap(ctx, plan.Labels)
+	if err != nil {
+		diags.AddError("Cannot update default machine pool", err.Error())
+		return
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	builder.Labels(labels)
+
+	patch, err := builder.Build()
+	if err != nil {
+		diags.AddError("Cannot build default machine pool patch", err.Error())
+		return
+	}
+	update, err := resource.Update().Body(patch).SendContext(ctx)
+	if err != nil {
+		diags.AddError(
+			"Cannot update default machine pool",
+			fmt.Sprintf(
+				"Cannot update default machine pool of cluster '%s': %v",
+				plan.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+	err = populateDefaultMachinePoolState(update.Body(), plan)
+	if err != nil {
+		diags.AddError("Cannot populate default machine pool state", err.Error())
+	}
+}
+
+// populateDefaultMachinePoolState copies the data from the API object to the Terraform state.
+func populateDefaultMachinePoolState(object *cmv1.MachinePool, state *DefaultMachinePoolState) error {
+	state.ID = types.StringValue(object.ID())
+	if autoscaling, ok := object.GetAutoscaling(); ok {
+		state.AutoScalingEnabled = types.BoolValue(true)
+		state.MinReplicas = types.Int64Value(int64(autoscaling.MinReplicas()))
+		state.MaxReplicas = types.Int64Value(int64(autoscaling.MaxReplicas()))
+		state.Replicas = types.Int64Null()
+	} else {
+		state.AutoScalingEnabled = types.BoolValue(false)
+		state.MinReplicas = types.Int64Null()
+		state.MaxReplicas = types.Int64Null()
+		state.Replicas = types.Int64Value(int64(object.Replicas()))
+	}
+
+	labels, ok := object.GetLabels()
+	if ok && len(labels) > 0 {
+		mapValue, err := common.ConvertStringMapToMapType(labels)
+		if err != nil {
+			return err
+		}
+		state.Labels = mapValue
+	} else {
+		state.Labels = types.MapNull(types.StringType)
+	}
+	return nil
+}
@@ -24,10 +24,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 type MachinePoolDatasource struct {
@@ -74,11 +77,16 @@ func (r *MachinePoolDatasource) Schema(ctx context.Context, req datasource.Schem
 				},
 			},
 			"id": schema.StringAttribute{
-				Description: "Unique identifier of the machine pool.",
-				Required:    true,
+				Description: "Unique identifier of the machine pool. Either 'id' or 'name' must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the machine pool",
+				Description: "The name of the machine pool. Either 'id' or 'name' must be set.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"machine_type": schema.StringAttribute{
@@ -184,11 +192,14 @@ func (r *MachinePoolDatasource) Read(ctx context.Context, req datasource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.ID = state.Name
+	// The name of a machine pool is also its identifier
+	if common.HasValue(state.Name) {
+		state.ID = state.Name
+	}
 
 	notFound, diags := readState(ctx, state, r.collection)
 	if notFound {
-		diags.AddError(
+		resp.Diagnostics.AddError(
 			"Failed to find machine pool",
 			fmt.Sprintf(
 				"Failed to find machine pool with identifier %s for cluster %s.",
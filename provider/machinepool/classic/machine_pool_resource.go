@@ -64,6 +64,7 @@ type MachinePoolResource struct {
 var _ resource.ResourceWithConfigure = &MachinePoolResource{}
 var _ resource.ResourceWithImportState = &MachinePoolResource{}
 var _ resource.ResourceWithConfigValidators = &MachinePoolResource{}
+var _ resource.ResourceWithModifyPlan = &MachinePoolResource{}
 
 func New() resource.Resource {
 	return &MachinePoolResource{}
@@ -257,6 +258,47 @@ func (r *MachinePoolResource) Configure(ctx context.Context, req resource.Config
 	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection)
 }
 
+// ModifyPlan checks that the subnet of a new machine pool belongs to the VPC of the cluster, so
+// that the plan fails instead of the apply.
+func (r *MachinePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the pool is destroyed or updated, the subnet can't be changed:
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.clusterCollection == nil {
+		return
+	}
+	plan := &MachinePoolState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if common.IsStringAttributeUnknownOrEmpty(plan.SubnetID) || common.IsStringAttributeUnknownOrEmpty(plan.Cluster) {
+		return
+	}
+
+	get, err := r.clusterCollection.Cluster(plan.Cluster.ValueString()).Get().SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't find cluster",
+			fmt.Sprintf(
+				"Can't find cluster with identifier '%s': %v",
+				plan.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+	err = r.validateSubnet(ctx, get.Body(), plan.SubnetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnet_id"),
+			"Invalid subnet",
+			fmt.Sprintf(
+				"Cannot build machine pool for cluster '%s': %v",
+				plan.Cluster.ValueString(), err,
+			),
+		)
+	}
+}
+
 func (r *MachinePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Get the plan:
 	plan := &MachinePoolState{}
@@ -899,6 +941,30 @@ func (r *MachinePoolResource) validateAZConfig(cluster *cmv1.Cluster, state *Mac
 	return state.MultiAvailabilityZone.ValueBool(), nil
 }
 
+// Validate that the subnet belongs to the VPC of a BYO VPC cluster, so that the user gets a clear
+// error instead of the one returned by the server when the machines are provisioned.
+func (r *MachinePoolResource) validateSubnet(ctx context.Context, cluster *cmv1.Cluster, subnetID string) error {
+	if len(cluster.AWS().SubnetIDs()) == 0 {
+		return nil
+	}
+	get, err := r.clusterCollection.Cluster(cluster.ID()).Vpc().Get().SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf("can't get the VPC of the cluster to validate subnet_id %s: %v", subnetID, err)
+	}
+	vpc := get.Body()
+	for _, subnet := range vpc.AWSSubnets() {
+		if subnet.SubnetID() == subnetID {
+			return nil
+		}
+	}
+	for _, subnet := range vpc.Subnets() {
+		if subnet == subnetID {
+			return nil
+		}
+	}
+	return fmt.Errorf("subnet_id %s doesn't belong to the VPC %s of the cluster", subnetID, vpc.ID())
+}
+
 func setSpotInstances(state *MachinePoolState) (*cmv1.AWSMachinePoolBuilder, error) {
 	useSpotInstances := common.HasValue(state.UseSpotInstances) && state.UseSpotInstances.ValueBool()
 	isSpotMaxPriceSet := common.HasValue(state.MaxSpotPrice)
@@ -1036,6 +1102,31 @@ func (r *MachinePoolResource) ImportState(ctx context.Context, req resource.Impo
 	}
 	clusterID := fields[0]
 	machinePoolID := fields[1]
+
+	// Machine pools of hosted control plane clusters are node pools, and are served by a different
+	// endpoint and resource:
+	get, err := r.clusterCollection.Cluster(clusterID).Get().SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't find cluster",
+			fmt.Sprintf(
+				"Can't find cluster with identifier '%s': %v",
+				clusterID, err,
+			),
+		)
+		return
+	}
+	if get.Body().Hypershift().Enabled() {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			fmt.Sprintf(
+				"Cluster '%s' is a hosted control plane cluster, its machine pools should be "+
+					"imported with the 'rhcs_hcp_machine_pool' resource",
+				clusterID,
+			),
+		)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), clusterID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), machinePoolID)...)
 }
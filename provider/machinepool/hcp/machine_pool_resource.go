@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -70,6 +71,7 @@ type HcpMachinePoolResource struct {
 var _ resource.ResourceWithConfigure = &HcpMachinePoolResource{}
 var _ resource.ResourceWithImportState = &HcpMachinePoolResource{}
 var _ resource.ResourceWithConfigValidators = &HcpMachinePoolResource{}
+var _ resource.ResourceWithModifyPlan = &HcpMachinePoolResource{}
 
 func New() resource.Resource {
 	return &HcpMachinePoolResource{}
@@ -188,11 +190,15 @@ func (r *HcpMachinePoolResource) Schema(ctx context.Context, req resource.Schema
 				Optional:    true,
 			},
 			"auto_repair": schema.BoolAttribute{
-				Description: "Indicates use of autor repair for the pool",
-				Required:    true,
+				Description: "Indicates use of auto repair for the pool. If not set, the OCM default is used, which enables it.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"version": schema.StringAttribute{
-				Description: "Desired version of OpenShift for the machine pool, for example '4.11.0'. If version is greater than the currently running version, an upgrade will be scheduled.",
+				Description: "Desired version of OpenShift for the machine pool, for example '4.11.0'. If version is greater than the currently running version, an upgrade will be scheduled. It can't be newer than the version of the control plane, or more than two minor versions behind it.",
 				Optional:    true,
 			},
 			"current_version": schema.StringAttribute{
@@ -248,6 +254,47 @@ func (r *HcpMachinePoolResource) Configure(ctx context.Context, req resource.Con
 	r.clusterWait = common.NewClusterWait(r.clusterCollection, connection)
 }
 
+// ModifyPlan checks the version of a new or upgraded machine pool against the version of the
+// control plane, so that the plan fails instead of the apply.
+func (r *HcpMachinePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the pool is destroyed:
+	if req.Plan.Raw.IsNull() || r.clusterCollection == nil {
+		return
+	}
+	plan := &HcpMachinePoolState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if common.IsStringAttributeUnknownOrEmpty(plan.Version) || common.IsStringAttributeUnknownOrEmpty(plan.Cluster) {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		state := &HcpMachinePoolState{}
+		diags = req.State.Get(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || plan.Version.Equal(state.Version) {
+			return
+		}
+	}
+
+	clusterObject := fetchCluster(ctx, plan, r.clusterCollection, &resp.Diagnostics)
+	if clusterObject == nil {
+		return
+	}
+	if controlPlaneVersion, ok := clusterObject.Version().GetRawID(); ok {
+		err := validateVersionSkew(controlPlaneVersion, plan.Version.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("version"),
+				"Invalid machine pool version",
+				err.Error(),
+			)
+		}
+	}
+}
+
 func (r *HcpMachinePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Get the plan:
 	plan := &HcpMachinePoolState{}
@@ -462,6 +509,17 @@ func (r *HcpMachinePoolResource) Create(ctx context.Context, req resource.Create
 	}
 
 	if common.HasValue(plan.Version) {
+		if controlPlaneVersion, ok := clusterObject.Version().GetRawID(); ok {
+			err = validateVersionSkew(controlPlaneVersion, plan.Version.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("version"),
+					"Invalid machine pool version",
+					err.Error(),
+				)
+				return
+			}
+		}
 		vBuilder := cmv1.NewVersion()
 		vBuilder.ID(ocmUtils.CreateVersionId(plan.Version.ValueString(), clusterObject.Version().ChannelGroup()))
 		vBuilder.ChannelGroup(clusterObject.Version().ChannelGroup())
@@ -714,6 +772,20 @@ func (r *HcpMachinePoolResource) doUpdate(ctx context.Context, state *HcpMachine
 		return diags
 	}
 
+	if common.HasValue(plan.Version) && !plan.Version.Equal(state.Version) {
+		if controlPlaneVersion, ok := clusterObject.Version().GetRawID(); ok {
+			err := validateVersionSkew(controlPlaneVersion, plan.Version.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("version"),
+					"Invalid machine pool version",
+					err.Error(),
+				)
+				return diags
+			}
+		}
+	}
+
 	resource := r.clusterCollection.Cluster(state.Cluster.ValueString()).
 		NodePools().
 		NodePool(state.ID.ValueString())
@@ -1254,6 +1326,31 @@ func (r *HcpMachinePoolResource) ImportState(ctx context.Context, req resource.I
 	}
 	clusterID := fields[0]
 	nodePoolId := fields[1]
+
+	// Machine pools of classic clusters aren't node pools, and are served by a different endpoint
+	// and resource:
+	get, err := r.clusterCollection.Cluster(clusterID).Get().SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't find cluster",
+			fmt.Sprintf(
+				"Can't find cluster with identifier '%s': %v",
+				clusterID, err,
+			),
+		)
+		return
+	}
+	if !get.Body().Hypershift().Enabled() {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			fmt.Sprintf(
+				"Cluster '%s' isn't a hosted control plane cluster, its machine pools should be "+
+					"imported with the 'rhcs_machine_pool' resource",
+				clusterID,
+			),
+		)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), clusterID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), nodePoolId)...)
 }
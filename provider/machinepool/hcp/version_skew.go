@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"fmt"
+	"strings"
+
+	semver "github.com/hashicorp/go-version"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+)
+
+// maxMinorVersionSkew is the number of minor versions that a machine pool can be behind the
+// control plane of the cluster.
+const maxMinorVersionSkew = 2
+
+// validateVersionSkew checks that the version of a machine pool isn't newer than the version of
+// the control plane, and that it isn't more than maxMinorVersionSkew minor versions behind it.
+func validateVersionSkew(controlPlaneVersion string, machinePoolVersion string) error {
+	controlPlane, err := semver.NewVersion(strings.TrimPrefix(controlPlaneVersion, rosa.VersionPrefix))
+	if err != nil {
+		return fmt.Errorf("failed to parse control plane version: %v", err)
+	}
+	machinePool, err := semver.NewVersion(strings.TrimPrefix(machinePoolVersion, rosa.VersionPrefix))
+	if err != nil {
+		return fmt.Errorf("failed to parse machine pool version: %v", err)
+	}
+	if machinePool.GreaterThan(controlPlane) {
+		return fmt.Errorf(
+			"machine pool version '%s' can't be newer than the control plane version '%s'",
+			machinePoolVersion, controlPlaneVersion,
+		)
+	}
+	controlPlaneSegments := controlPlane.Segments()
+	machinePoolSegments := machinePool.Segments()
+	if controlPlaneSegments[0] != machinePoolSegments[0] ||
+		controlPlaneSegments[1]-machinePoolSegments[1] > maxMinorVersionSkew {
+		return fmt.Errorf(
+			"machine pool version '%s' is more than %d minor versions behind the control plane "+
+				"version '%s'",
+			machinePoolVersion, maxMinorVersionSkew, controlPlaneVersion,
+		)
+	}
+	return nil
+}
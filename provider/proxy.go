@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// parseProxyURL checks that the given text is a proxy URL supported by the HTTP transport.
+func parseProxyURL(text string) (*url.URL, error) {
+	result, err := url.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	switch result.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf(
+			"scheme '%s' isn't supported, it should be 'http', 'https' or 'socks5'",
+			result.Scheme,
+		)
+	}
+	if result.Host == "" {
+		return nil, fmt.Errorf("the host is missing")
+	}
+	return result, nil
+}
+
+// proxyTransportWrapper returns a transport wrapper that sends the requests through the given
+// proxy instead of the one selected by the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment
+// variables. The user name and password of the URL, if any, are used to authenticate to the
+// proxy. It must be the last wrapper added to the connection, as it needs to receive the
+// transport created by the SDK.
+func proxyTransportWrapper(proxy *url.URL) func(http.RoundTripper) http.RoundTripper {
+	return func(wrapped http.RoundTripper) http.RoundTripper {
+		transport, ok := wrapped.(*http.Transport)
+		if !ok {
+			return wrapped
+		}
+		transport = transport.Clone()
+		transport.Proxy = http.ProxyURL(proxy)
+		return transport
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ocmConsts "github.com/openshift-online/ocm-common/pkg/ocm/consts"
+	ocmUtils "github.com/openshift-online/ocm-common/pkg/ocm/utils"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type AvailableUpgradesDataSource struct {
+	clusterCollection *cmv1.ClustersClient
+	versionCollection *cmv1.VersionsClient
+}
+
+var _ datasource.DataSource = &AvailableUpgradesDataSource{}
+var _ datasource.DataSourceWithConfigure = &AvailableUpgradesDataSource{}
+
+func NewAvailableUpgrades() datasource.DataSource {
+	return &AvailableUpgradesDataSource{}
+}
+
+func (s *AvailableUpgradesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_upgrades"
+}
+
+func (s *AvailableUpgradesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of OpenShift versions that a cluster or a version can be upgraded to.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster. Either 'cluster' or 'version' must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("version")),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version to upgrade from, for example '4.14.1' or 'openshift-v4.14.1'. " +
+					"Either 'cluster' or 'version' must be set.",
+				Optional: true,
+			},
+			"versions": schema.ListAttribute{
+				Description: "Versions available for upgrade, sorted from the oldest to the newest.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (s *AvailableUpgradesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*sdk.Connection)
+
+	// Get the collections of clusters and versions:
+	s.clusterCollection = connection.ClustersMgmt().V1().Clusters()
+	s.versionCollection = connection.ClustersMgmt().V1().Versions()
+}
+
+func (s *AvailableUpgradesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &AvailableUpgradesState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the version to upgrade from:
+	var version *cmv1.Version
+	if common.HasValue(state.Cluster) {
+		get, err := s.clusterCollection.Cluster(state.Cluster.ValueString()).Get().SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't find cluster",
+				fmt.Sprintf(
+					"Can't find cluster with identifier '%s': %v",
+					state.Cluster.ValueString(), err,
+				),
+			)
+			return
+		}
+		version = get.Body().Version()
+	} else {
+		versionID := state.Version.ValueString()
+		if !strings.HasPrefix(versionID, rosa.VersionPrefix) {
+			versionID = ocmUtils.CreateVersionId(versionID, ocmConsts.DefaultChannelGroup)
+		}
+		get, err := s.versionCollection.Version(versionID).Get().SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't find version",
+				fmt.Sprintf(
+					"Can't find version with identifier '%s': %v",
+					versionID, err,
+				),
+			)
+			return
+		}
+		version = get.Body()
+	}
+
+	// Populate the state:
+	upgrades := sortVersions(version.AvailableUpgrades())
+	state.Versions, diags = types.ListValueFrom(ctx, types.StringType, upgrades)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// sortVersions returns a copy of the given versions sorted from the oldest to the newest. Versions
+// that can't be parsed are sorted lexicographically after the rest.
+func sortVersions(versions []string) []string {
+	result := make([]string, len(versions))
+	copy(result, versions)
+	sort.SliceStable(result, func(i, j int) bool {
+		left, leftErr := semver.NewVersion(result[i])
+		right, rightErr := semver.NewVersion(result[j])
+		switch {
+		case leftErr == nil && rightErr == nil:
+			return left.LessThan(right)
+		case leftErr == nil:
+			return true
+		case rightErr == nil:
+			return false
+		default:
+			return result[i] < result[j]
+		}
+	})
+	return result
+}
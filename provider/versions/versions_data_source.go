@@ -24,8 +24,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
+// defaultPageSize is the number of versions requested in each page when the `page_size`
+// attribute isn't set.
+const defaultPageSize = 100
+
 type VersionsDataSource struct {
 	collection *cmv1.VersionsClient
 }
@@ -53,6 +59,7 @@ func (s *VersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Description: "Order criteria.",
 				Optional:    true,
 			},
+			"page_size": common.PageSizeAttribute(defaultPageSize),
 			"item": schema.SingleNestedAttribute{
 				Description: "Content of the list when there is exactly one item.",
 				Attributes:  s.itemAttributes(),
@@ -108,7 +115,7 @@ func (s *VersionsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	// Fetch the list of versions:
 	var listItems []*cmv1.Version
-	listSize := 100
+	listSize := common.PageSize(state.PageSize, defaultPageSize)
 	listPage := 1
 	listRequest := s.collection.List().Size(listSize)
 	if !state.Search.IsUnknown() && !state.Search.IsNull() {
@@ -150,11 +157,7 @@ func (s *VersionsDataSource) Read(ctx context.Context, req datasource.ReadReques
 			Name: types.StringValue(listItem.RawID()),
 		}
 	}
-	if len(state.Items) == 1 {
-		state.Item = state.Items[0]
-	} else {
-		state.Item = nil
-	}
+	state.Item = common.PopulateSingleItem(state.Items)
 
 	// Save the state:
 	diags = resp.State.Set(ctx, state)
@@ -19,10 +19,11 @@ package versions
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type VersionsState struct {
-	Search types.String    `tfsdk:"search"`
-	Order  types.String    `tfsdk:"order"`
-	Item   *VersionState   `tfsdk:"item"`
-	Items  []*VersionState `tfsdk:"items"`
+	Search   types.String    `tfsdk:"search"`
+	Order    types.String    `tfsdk:"order"`
+	PageSize types.Int64     `tfsdk:"page_size"`
+	Item     *VersionState   `tfsdk:"item"`
+	Items    []*VersionState `tfsdk:"items"`
 }
 
 type VersionState struct {
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Available upgrades data source", func() {
+	It("Lists the sorted upgrades of a version", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "openshift-v4.14.1",
+				  "raw_id": "4.14.1",
+				  "available_upgrades": [
+				    "4.15.0",
+				    "4.14.10",
+				    "4.14.2",
+				    "4.14.9"
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_available_upgrades" "my_upgrades" {
+		    version = "4.14.1"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_available_upgrades", "my_upgrades")
+		Expect(resource).To(MatchJQ(`.attributes.versions | length`, 4))
+		Expect(resource).To(MatchJQ(`.attributes.versions[0]`, "4.14.2"))
+		Expect(resource).To(MatchJQ(`.attributes.versions[1]`, "4.14.9"))
+		Expect(resource).To(MatchJQ(`.attributes.versions[2]`, "4.14.10"))
+		Expect(resource).To(MatchJQ(`.attributes.versions[3]`, "4.15.0"))
+	})
+
+	It("Accepts a version identifier", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1-fast"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "openshift-v4.14.1-fast",
+				  "raw_id": "4.14.1",
+				  "available_upgrades": [
+				    "4.14.2"
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_available_upgrades" "my_upgrades" {
+		    version = "openshift-v4.14.1-fast"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_available_upgrades", "my_upgrades")
+		Expect(resource).To(MatchJQ(`.attributes.versions`, []interface{}{"4.14.2"}))
+	})
+
+	It("Lists the sorted upgrades of a cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "version": {
+				    "id": "openshift-v4.14.1",
+				    "raw_id": "4.14.1",
+				    "available_upgrades": [
+				      "4.14.3",
+				      "4.14.11",
+				      "4.14.2"
+				    ]
+				  }
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_available_upgrades" "my_upgrades" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_available_upgrades", "my_upgrades")
+		Expect(resource).To(MatchJQ(`.attributes.versions | length`, 3))
+		Expect(resource).To(MatchJQ(`.attributes.versions[0]`, "4.14.2"))
+		Expect(resource).To(MatchJQ(`.attributes.versions[1]`, "4.14.3"))
+		Expect(resource).To(MatchJQ(`.attributes.versions[2]`, "4.14.11"))
+	})
+
+	It("Fails if both cluster and version are set", func() {
+		Terraform.Source(`
+		  data "rhcs_available_upgrades" "my_upgrades" {
+		    cluster = "123"
+		    version = "4.14.1"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+	})
+})
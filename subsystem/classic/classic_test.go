@@ -17,6 +17,7 @@ limitations under the License.
 package classic
 
 import (
+	"os"
 	"testing"
 	"time"
 
@@ -33,19 +34,38 @@ func TestProvider(t *testing.T) {
 	RunSpecs(t, "Classic Subsystem")
 }
 
+// serverCA is the file containing the CA certificate of the API test server.
+var serverCA string
+
+// pluginCacheDir is the directory where the runners cache the providers that they install, so
+// that each test doesn't install them again.
+var pluginCacheDir string
+
+var _ = BeforeSuite(func() {
+	var err error
+	pluginCacheDir, err = os.MkdirTemp("", "rhcs-plugin-cache-*.d")
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	err := os.RemoveAll(pluginCacheDir)
+	Expect(err).ToNot(HaveOccurred())
+})
+
 var _ = BeforeEach(func() {
 	format.MaxLength = 0 // set gomega format MaxLength to 0 to see all the diff when fails
 	// Create the server:
-	var ca string
-	TestServer, ca = MakeTCPTLSServer()
+	TestServer, serverCA = MakeTCPTLSServer()
 	// Create an access token:
 	token := MakeTokenString("Bearer", 10*time.Minute)
 
 	// Create the runner:
 	Terraform = NewTerraformRunner().
 		URL(TestServer.URL()).
-		CA(ca).
+		CA(serverCA).
 		Token(token).
+		PluginCacheDir(pluginCacheDir).
+		DevOverride(os.Getenv("RHCS_DEV_OVERRIDE")).
 		Build()
 })
 
@@ -71,6 +71,77 @@ var _ = Describe("Cloud providers data source", func() {
 		Expect(resource).To(MatchJQ(`.attributes.items[1].display_name`, "GCP"))
 	})
 
+	It("Doesn't plan changes after listing cloud providers", func() {
+		// Prepare the server for the apply and for the refresh of the plan:
+		cloudProviders := `{
+		  "page": 1,
+		  "size": 1,
+		  "total": 1,
+		  "items": [
+		    {
+		      "id": "aws",
+		      "name": "aws",
+		      "display_name": "AWS"
+		    }
+		  ]
+		}`
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				RespondWithJSON(http.StatusOK, cloudProviders),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				RespondWithJSON(http.StatusOK, cloudProviders),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check that a second apply wouldn't change anything:
+		Terraform.AssertIdempotent()
+	})
+
+	It("Sends a single request to list cloud providers", func() {
+		// Prepare the server:
+		server := NewCountingServer(TestServer)
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 1,
+				  "total": 1,
+				  "items": [
+				    {
+				      "id": "aws",
+				      "name": "aws",
+				      "display_name": "AWS"
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check that the data source didn't send any other request:
+		server.AssertCount(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers", 1)
+		Expect(server.Counts()).To(HaveLen(1))
+	})
+
 	It("Can search cloud providers", func() {
 		// Prepare the server:
 		TestServer.AppendHandlers(
@@ -220,4 +291,102 @@ var _ = Describe("Cloud providers data source", func() {
 		resource := Terraform.Resource("rhcs_cloud_providers", "all")
 		Expect(resource).To(MatchJQ(`.attributes.item`, nil))
 	})
+
+	It("Uses the default page size", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				VerifyFormKV("size", "100"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Uses the configured page size", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				VerifyFormKV("size", "1"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 1,
+				  "total": 2,
+				  "items": [
+				    {
+				      "id": "aws",
+				      "name": "aws",
+				      "display_name": "AWS"
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				VerifyFormKV("size", "1"),
+				VerifyFormKV("page", "2"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 2,
+				  "size": 1,
+				  "total": 2,
+				  "items": [
+				    {
+				      "id": "gcp",
+				      "name": "gcp",
+				      "display_name": "GCP"
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				VerifyFormKV("size", "1"),
+				VerifyFormKV("page", "3"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 3,
+				  "size": 0,
+				  "total": 2,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		    page_size = 1
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cloud_providers", "all")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 2))
+	})
+
+	It("Fails if the page size is out of range", func() {
+		Terraform.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		    page_size = 0
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("page_size")
+	})
 })
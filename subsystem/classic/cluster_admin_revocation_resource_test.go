@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster admin revocation", func() {
+	const identityProviders = `{
+	  "kind": "IdentityProviderList",
+	  "page": 1,
+	  "size": 2,
+	  "total": 2,
+	  "items": [
+	    {
+	      "kind": "IdentityProvider",
+	      "id": "789",
+	      "name": "my-github",
+	      "type": "GithubIdentityProvider"
+	    },
+	    {
+	      "kind": "IdentityProvider",
+	      "id": "456",
+	      "name": "cluster-admin",
+	      "type": "HTPasswdIdentityProvider"
+	    }
+	  ]
+	}`
+	const adminUsers = `{
+	  "kind": "HTPasswdUserList",
+	  "page": 1,
+	  "size": 1,
+	  "total": 1,
+	  "items": [
+	    {
+	      "kind": "HTPasswdUser",
+	      "id": "a1",
+	      "username": "cluster-admin"
+	    }
+	  ]
+	}`
+
+	BeforeEach(func() {
+		// The first thing that the provider will do is check that the cluster is ready, so we
+		// always need to prepare the server to respond to that:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "state": "ready"
+				}`),
+			),
+		)
+	})
+
+	It("Revokes the cluster admin when there is another cluster admin", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/identity_providers"),
+				RespondWithJSON(http.StatusOK, identityProviders),
+			),
+			CombineHandlers(
+				VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/identity_providers/456/htpasswd_users",
+				),
+				RespondWithJSON(http.StatusOK, adminUsers),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/groups/cluster-admins/users"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "UserList",
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "kind": "User",
+				      "id": "cluster-admin"
+				    },
+				    {
+				      "kind": "User",
+				      "id": "my-admin"
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(
+					http.MethodDelete,
+					"/api/clusters_mgmt/v1/clusters/123/groups/cluster-admins/users/cluster-admin",
+				),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123/identity_providers/456"),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster_admin_revocation" "revoke" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster_admin_revocation", "revoke")
+		Expect(resource).To(MatchJQ(".attributes.id", "123"))
+	})
+
+	It("Fails if there is no other cluster admin", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/identity_providers"),
+				RespondWithJSON(http.StatusOK, identityProviders),
+			),
+			CombineHandlers(
+				VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/identity_providers/456/htpasswd_users",
+				),
+				RespondWithJSON(http.StatusOK, adminUsers),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/groups/cluster-admins/users"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "UserList",
+				  "page": 1,
+				  "size": 1,
+				  "total": 1,
+				  "items": [
+				    {
+				      "kind": "User",
+				      "id": "cluster-admin"
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command and check that nothing was deleted:
+		Terraform.Source(`
+		  resource "rhcs_cluster_admin_revocation" "revoke" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Can't revoke cluster admin")
+		runOutput.VerifyErrorContainsSubstring("has no other member of group 'cluster-admins'")
+		Expect(TestServer.ReceivedRequests()).To(HaveLen(4))
+	})
+})
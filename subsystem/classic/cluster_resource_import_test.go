@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster import", func() {
+	// This is the cluster that will be returned by the server when asked to retrieve the
+	// imported cluster.
+	const template = `{
+	  "id": "123",
+	  "product": {
+	    "id": "osd"
+	  },
+	  "name": "my-cluster",
+	  "domain_prefix": "my-cluster",
+	  "cloud_provider": {
+	    "id": "aws"
+	  },
+	  "region": {
+	    "id": "us-west-1"
+	  },
+	  "multi_az": false,
+	  "properties": {},
+	  "api": {
+	    "url": "https://my-api.example.com"
+	  },
+	  "console": {
+	    "url": "https://my-console.example.com"
+	  },
+	  "nodes": {
+	    "compute": 3,
+	    "availability_zones": ["us-west-1a"],
+	    "compute_machine_type": {
+	      "id": "r5.xlarge"
+	    }
+	  },
+	  "ccs": {
+	    "enabled": false
+	  },
+	  "network": {
+	    "machine_cidr": "10.0.0.0/16",
+	    "service_cidr": "172.30.0.0/16",
+	    "pod_cidr": "10.128.0.0/14",
+	    "host_prefix": 23
+	  },
+	  "proxy": {
+	    "http_proxy": "http://proxy.example.com",
+	    "https_proxy": "https://proxy.example.com"
+	  },
+	  "version": {
+	    "id": "openshift-4.8.0"
+	  },
+	  "state": "ready"
+	}`
+
+	It("Imports a cluster and then plans no changes", func() {
+		// Prepare the server for the import and for the refresh of the plan:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, template),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, template),
+			),
+		)
+
+		// Run the import command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+		    product        = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    proxy = {
+		      http_proxy  = "http://proxy.example.com"
+		      https_proxy = "https://proxy.example.com"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Import("rhcs_cluster.my_cluster", "123")
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.id", "123"))
+		Expect(resource).To(MatchJQ(".attributes.name", "my-cluster"))
+		Expect(resource).To(MatchJQ(".attributes.product", "osd"))
+		Expect(resource).To(MatchJQ(".attributes.cloud_provider", "aws"))
+		Expect(resource).To(MatchJQ(".attributes.cloud_region", "us-west-1"))
+		Expect(resource).To(MatchJQ(".attributes.compute_nodes", 3.0))
+		Expect(resource).To(MatchJQ(".attributes.compute_machine_type", "r5.xlarge"))
+		Expect(resource).To(MatchJQ(".attributes.availability_zones", []interface{}{"us-west-1a"}))
+		Expect(resource).To(MatchJQ(".attributes.machine_cidr", "10.0.0.0/16"))
+		Expect(resource).To(MatchJQ(".attributes.proxy.http_proxy", "http://proxy.example.com"))
+		Expect(resource).To(MatchJQ(".attributes.proxy.https_proxy", "https://proxy.example.com"))
+		Expect(resource).To(MatchJQ(".attributes.version", "openshift-4.8.0"))
+
+		// Check that the plan doesn't contain any change:
+		runOutput = Terraform.Plan()
+		Expect(runOutput.ExitCode).To(BeZero())
+		runOutput.VerifyOutputContainsSubstring("No changes.")
+	})
+
+	It("Fails to import a cluster that doesn't exist", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/456"),
+				RespondWithJSON(http.StatusNotFound, `{
+				  "kind": "Error",
+				  "id": "404",
+				  "href": "/api/clusters_mgmt/v1/errors/404",
+				  "code": "CLUSTERS-MGMT-404",
+				  "reason": "Cluster '456' not found"
+				}`),
+			),
+		)
+
+		// Run the import command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+		    product        = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		  }
+		`)
+		runOutput := Terraform.Import("rhcs_cluster.my_cluster", "456")
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Can't find cluster")
+	})
+})
@@ -635,6 +635,129 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 			Expect(resource).To(MatchJQ(".attributes.infra_id", "my-cluster-123"))
 		})
 
+		It("Creates basic cluster with the cloud permission checks disabled", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					RespondWithJSON(http.StatusOK, versionListPage1),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					VerifyJQ(`.name`, "my-cluster"),
+					VerifyJQ(`.ccs.enabled`, true),
+					VerifyJQ(`.ccs.disable_scp_checks`, true),
+					RespondWithPatchedJSON(http.StatusCreated, template, `[
+					{
+					  "op": "add",
+					  "path": "/ccs",
+					  "value": {
+						  "enabled": true,
+						  "disable_scp_checks": true
+					  }
+					},
+					{
+					  "op": "add",
+					  "path": "/aws",
+					  "value": {
+						  "ec2_metadata_http_tokens": "optional",
+						  "sts" : {
+							  "oidc_endpoint_url": "https://127.0.0.1",
+							  "thumbprint": "111111",
+							  "role_arn": "",
+							  "support_role_arn": "",
+							  "instance_iam_roles" : {
+								"master_role_arn" : "",
+								"worker_role_arn" : ""
+							  },
+							  "operator_role_prefix" : "test"
+						  }
+					  }
+					}]`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+		  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+		    name               = "my-cluster"
+			domain_prefix      = "mydomainprefix"
+		    cloud_region       = "us-west-1"
+			aws_account_id     = "123456789012"
+			disable_scp_checks = true
+			sts = {
+				operator_role_prefix = "test"
+				role_arn = "",
+				support_role_arn = "",
+				instance_iam_roles = {
+					master_role_arn = "",
+					worker_role_arn = "",
+				}
+			}
+		  }
+		`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			runOutput.VerifyOutputContainsSubstring("Cloud permission checks are disabled")
+			resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.disable_scp_checks", true))
+		})
+
+		It("Doesn't send the cloud permission checks flag by default", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					RespondWithJSON(http.StatusOK, versionListPage1),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					VerifyJQ(`.ccs.enabled`, true),
+					VerifyJQ(`.ccs | has("disable_scp_checks")`, false),
+					RespondWithPatchedJSON(http.StatusCreated, template, `[
+					{
+					  "op": "add",
+					  "path": "/aws",
+					  "value": {
+						  "ec2_metadata_http_tokens": "optional",
+						  "sts" : {
+							  "oidc_endpoint_url": "https://127.0.0.1",
+							  "thumbprint": "111111",
+							  "role_arn": "",
+							  "support_role_arn": "",
+							  "instance_iam_roles" : {
+								"master_role_arn" : "",
+								"worker_role_arn" : ""
+							  },
+							  "operator_role_prefix" : "test"
+						  }
+					  }
+					}]`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+		  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+		    name           = "my-cluster"
+			domain_prefix  = "mydomainprefix"
+		    cloud_region   = "us-west-1"
+			aws_account_id = "123456789012"
+			sts = {
+				operator_role_prefix = "test"
+				role_arn = "",
+				support_role_arn = "",
+				instance_iam_roles = {
+					master_role_arn = "",
+					worker_role_arn = "",
+				}
+			}
+		  }
+		`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
 		It("Creates basic cluster returned empty az list", func() {
 			// Prepare the server:
 			TestServer.AppendHandlers(
@@ -2770,7 +2893,7 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 					  "path": "/aws",
 					  "value": {
 						  "private_link": false,
-						  "subnet_ids": ["id1", "id2", "id3"],
+						  "subnet_ids": ["id1", "id2"],
 						  "ec2_metadata_http_tokens": "optional",
 						  "sts" : {
 							  "oidc_endpoint_url": "https://127.0.0.1",
@@ -2823,7 +2946,7 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 			aws_private_link = false
 			private = true
 			aws_subnet_ids = [
-				"id1", "id2", "id3"
+				"id1", "id2"
 			]
 			sts = {
 				operator_role_prefix = "test"
@@ -2862,7 +2985,7 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 					  "path": "/aws",
 					  "value": {
 						  "private_link": true,
-						  "subnet_ids": ["id1", "id2", "id3"],
+						  "subnet_ids": ["id1"],
 						  "ec2_metadata_http_tokens": "optional",
 						  "sts" : {
 							  "oidc_endpoint_url": "https://127.0.0.1",
@@ -2910,7 +3033,92 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 			private = true
 			aws_private_link = true
 			aws_subnet_ids = [
-				"id1", "id2", "id3"
+				"id1"
+			]
+			sts = {
+				operator_role_prefix = "test"
+				role_arn = "",
+				support_role_arn = "",
+				instance_iam_roles = {
+					master_role_arn = "",
+					worker_role_arn = "",
+				}
+			}
+		  }
+		`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("Creates multi AZ cluster with aws subnet ids", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					RespondWithJSON(http.StatusOK, versionListPage1),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					VerifyJQ(`.name`, "my-cluster"),
+					VerifyJQ(`.multi_az`, true),
+					VerifyJQ(`.aws.subnet_ids | length`, 6.0),
+					VerifyJQ(`.aws.private_link`, false),
+					VerifyJQ(`.nodes.availability_zones | length`, 3.0),
+					RespondWithPatchedJSON(http.StatusOK, template, `[
+					{
+					  "op": "add",
+					  "path": "/aws",
+					  "value": {
+						  "private_link": false,
+						  "subnet_ids": ["id1", "id2", "id3", "id4", "id5", "id6"],
+						  "ec2_metadata_http_tokens": "optional",
+						  "sts" : {
+							  "oidc_endpoint_url": "https://127.0.0.1",
+							  "thumbprint": "111111",
+							  "role_arn": "",
+							  "support_role_arn": "",
+							  "instance_iam_roles" : {
+								"master_role_arn" : "",
+								"worker_role_arn" : ""
+							  },
+							  "operator_role_prefix" : "test"
+						  }
+					  }
+					},
+					{
+						"op": "add",
+						"path": "/availability_zones",
+						"value": ["us-west-1a", "us-west-1b", "us-west-1c"]
+					},
+					{
+					  "op": "replace",
+					  "path": "/nodes",
+					  "value": {
+						"compute": 3,
+						"availability_zones": [
+							"us-west-1a",
+							"us-west-1b",
+							"us-west-1c"
+						],
+						"compute_machine_type": {
+						   "id": "r5.xlarge"
+						}
+					  }
+					}
+					]`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+		  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+		    name           = "my-cluster"
+		    cloud_region   = "us-west-1"
+			aws_account_id = "123456789012"
+			multi_az = true
+			availability_zones = ["us-west-1a", "us-west-1b", "us-west-1c"]
+			aws_subnet_ids = [
+				"id1", "id2", "id3", "id4", "id5", "id6"
 			]
 			sts = {
 				operator_role_prefix = "test"
@@ -2925,6 +3133,44 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 		`)
 			runOutput := Terraform.Apply()
 			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.aws_subnet_ids | length", 6))
+		})
+
+		It("Fails to create multi AZ cluster with a single subnet per zone", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					RespondWithJSON(http.StatusOK, versionListPage1),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+		  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+		    name           = "my-cluster"
+		    cloud_region   = "us-west-1"
+			aws_account_id = "123456789012"
+			multi_az = true
+			availability_zones = ["us-west-1a", "us-west-1b", "us-west-1c"]
+			aws_subnet_ids = [
+				"id1", "id2", "id3"
+			]
+			sts = {
+				operator_role_prefix = "test"
+				role_arn = "",
+				support_role_arn = "",
+				instance_iam_roles = {
+					master_role_arn = "",
+					worker_role_arn = "",
+				}
+			}
+		  }
+		`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("The number of subnets for a multi-AZ cluster should be 6, instead received: 3")
 		})
 
 		It("Creates cluster when private link is false", func() {
@@ -3010,7 +3256,7 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 					  "op": "add",
 					  "path": "/aws",
 					  "value": {
-						  "subnet_ids": ["id1", "id2", "id3"],
+						  "subnet_ids": ["id1", "id2"],
 						  "ec2_metadata_http_tokens": "optional",
                           "private_hosted_zone_id": "1234",
                           "private_hosted_zone_role_arn": "arn:aws:iam::111111111111:role/test-shared-vpc",
@@ -3059,7 +3305,7 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 			aws_account_id = "123456789012"
 			availability_zones = ["us-west-1a"]
 			aws_subnet_ids = [
-				"id1", "id2", "id3"
+				"id1", "id2"
 			]
 			sts = {
 				operator_role_prefix = "test"
@@ -3501,7 +3747,7 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 					  "path": "/aws",
 					  "value": {
 						  "private_link": false,
-						  "subnet_ids": ["id1", "id2", "id3"],
+						  "subnet_ids": ["id1", "id2"],
 						  "additional_compute_security_group_ids": ["id1"],
 						  "additional_infra_security_group_ids": ["id2"],
 						  "additional_control_plane_security_group_ids": ["id3"],
@@ -3557,7 +3803,7 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 			aws_private_link = false
 			private = true
 			aws_subnet_ids = [
-				"id1", "id2", "id3"
+				"id1", "id2"
 			]
 			aws_additional_compute_security_group_ids = [
 				"id1"
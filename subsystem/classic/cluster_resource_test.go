@@ -124,6 +124,138 @@ var _ = Describe("Cluster creation", func() {
 		Expect(resource).To(MatchJQ(".attributes.console_url", "https://my-console.example.com"))
 	})
 
+	It("Saves the OAuth URL derived from the console URL to the state", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/api/url",
+				    "value": "https://api.my-cluster.abcd.p1.openshiftapps.com:6443"
+				  },
+				  {
+				    "op": "replace",
+				    "path": "/console/url",
+				    "value": "https://console-openshift-console.apps.my-cluster.abcd.p1.openshiftapps.com"
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(
+			".attributes.api_url",
+			"https://api.my-cluster.abcd.p1.openshiftapps.com:6443",
+		))
+		Expect(resource).To(MatchJQ(
+			".attributes.console_url",
+			"https://console-openshift-console.apps.my-cluster.abcd.p1.openshiftapps.com",
+		))
+		Expect(resource).To(MatchJQ(
+			".attributes.oauth_url",
+			"https://oauth-openshift.apps.my-cluster.abcd.p1.openshiftapps.com",
+		))
+	})
+
+	It("Saves an empty OAuth URL while the cluster isn't ready", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "remove",
+				    "path": "/api"
+				  },
+				  {
+				    "op": "remove",
+				    "path": "/console"
+				  },
+				  {
+				    "op": "replace",
+				    "path": "/state",
+				    "value": "installing"
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    wait           = false
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.api_url", ""))
+		Expect(resource).To(MatchJQ(".attributes.console_url", ""))
+		Expect(resource).To(MatchJQ(".attributes.oauth_url", ""))
+	})
+
+	It("Plans no changes after restoring a snapshot of the state", func() {
+		// Prepare the server for the creation and for the refresh of the plan:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusCreated, template),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, template),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+		    product        = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+		snapshot, err := Terraform.SnapshotState()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Remove the cluster from the state out of band:
+		runOutput = Terraform.Run("state", "rm", "rhcs_cluster.my_cluster")
+		Expect(runOutput.ExitCode).To(BeZero())
+		Expect(Terraform.ResourceCount("rhcs_cluster")).To(BeZero())
+
+		// Restore the state and check that the plan doesn't contain any change:
+		err = Terraform.RestoreState(snapshot)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Terraform.ResourceCount("rhcs_cluster")).To(Equal(1))
+		runOutput = Terraform.Plan()
+		Expect(runOutput.ExitCode).To(BeZero())
+		runOutput.VerifyOutputContainsSubstring("No changes.")
+	})
+
 	It("Sets compute nodes and machine type", func() {
 		// Prepare the server:
 		TestServer.AppendHandlers(
@@ -155,6 +287,275 @@ var _ = Describe("Cluster creation", func() {
 		Expect(resource).To(MatchJQ(".attributes.compute_machine_type", "r5.xlarge"))
 	})
 
+	It("Sets the compute nodes of a single zone cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.multi_az`, false),
+				VerifyJQ(`.nodes.compute`, 2.0),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/nodes/compute",
+				    "value": 2
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+		    product        = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    multi_az       = false
+		    compute_nodes  = 2
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.compute_nodes", 2.0))
+	})
+
+	It("Sets the compute nodes of a multi zone cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.multi_az`, true),
+				VerifyJQ(`.nodes.compute`, 6.0),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/multi_az",
+				    "value": true
+				  },
+				  {
+				    "op": "replace",
+				    "path": "/nodes/compute",
+				    "value": 6
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+		    product        = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    multi_az       = true
+		    compute_nodes  = 6
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.compute_nodes", 6.0))
+	})
+
+	It("Fails if the compute nodes of a multi zone cluster aren't a multiple of 3", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+		    product        = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    multi_az       = true
+		    compute_nodes  = 4
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid number of compute nodes")
+	})
+
+	It("Fails if the number of compute nodes isn't positive", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+		    product        = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    compute_nodes  = 0
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid number of compute nodes")
+	})
+
+	It("Enables the autoscaling of the default compute pool", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.multi_az`, true),
+				VerifyJQ(`.nodes.autoscale_compute.min_replicas`, 3.0),
+				VerifyJQ(`.nodes.autoscale_compute.max_replicas`, 6.0),
+				VerifyJQ(`.nodes.compute`, nil),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/multi_az",
+				    "value": true
+				  },
+				  {
+				    "op": "remove",
+				    "path": "/nodes/compute"
+				  },
+				  {
+				    "op": "add",
+				    "path": "/nodes/autoscale_compute",
+				    "value": {
+				      "min_replicas": 3,
+				      "max_replicas": 6
+				    }
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                = "my-cluster"
+		    product             = "osd"
+		    cloud_provider      = "aws"
+		    cloud_region        = "us-west-1"
+		    multi_az            = true
+		    autoscaling_enabled = true
+		    min_replicas        = 3
+		    max_replicas        = 6
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.autoscaling_enabled", true))
+		Expect(resource).To(MatchJQ(".attributes.min_replicas", 3.0))
+		Expect(resource).To(MatchJQ(".attributes.max_replicas", 6.0))
+
+		// Disable the autoscaling in the server and refresh the state:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithPatchedJSON(http.StatusOK, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/multi_az",
+				    "value": true
+				  }
+				]`),
+			),
+		)
+		runOutput = Terraform.Run("apply", "-refresh-only", "-auto-approve", "-no-color")
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check that the state keeps the configured values, as changing them would replace
+		// the cluster:
+		resource = Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.autoscaling_enabled", true))
+		Expect(resource).To(MatchJQ(".attributes.min_replicas", 3.0))
+		Expect(resource).To(MatchJQ(".attributes.max_replicas", 6.0))
+	})
+
+	It("Fails if the replicas aren't set when enabling autoscaling", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                = "my-cluster"
+		    product             = "osd"
+		    cloud_provider      = "aws"
+		    cloud_region        = "us-west-1"
+		    autoscaling_enabled = true
+		    min_replicas        = 2
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Attributes 'min_replicas' and 'max_replicas' are required")
+	})
+
+	It("Fails if the minimum replicas are greater than the maximum", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                = "my-cluster"
+		    product             = "osd"
+		    cloud_provider      = "aws"
+		    cloud_region        = "us-west-1"
+		    autoscaling_enabled = true
+		    min_replicas        = 4
+		    max_replicas        = 2
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid number of replicas")
+	})
+
+	It("Fails if the replicas of a multi zone cluster aren't a multiple of 3", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                = "my-cluster"
+		    product             = "osd"
+		    cloud_provider      = "aws"
+		    cloud_region        = "us-west-1"
+		    multi_az            = true
+		    autoscaling_enabled = true
+		    min_replicas        = 3
+		    max_replicas        = 4
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid number of replicas")
+	})
+
+	It("Fails if the replicas are set without enabling autoscaling", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+		    product        = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    min_replicas   = 2
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("autoscaling isn't enabled")
+	})
+
+	It("Fails if the compute nodes are set together with autoscaling", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                = "my-cluster"
+		    product             = "osd"
+		    cloud_provider      = "aws"
+		    cloud_region        = "us-west-1"
+		    compute_nodes       = 3
+		    autoscaling_enabled = true
+		    min_replicas        = 2
+		    max_replicas        = 4
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("conflicting compute nodes settings")
+	})
+
 	It("Creates CCS cluster", func() {
 		// Prepare the server:
 		TestServer.AppendHandlers(
@@ -257,21 +658,923 @@ var _ = Describe("Cluster creation", func() {
 		Expect(resource).To(MatchJQ(".attributes.host_prefix", 22.0))
 	})
 
-	It("Sets version", func() {
-		// Prepare the server:
-		TestServer.AppendHandlers(
-			CombineHandlers(
-				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
-				VerifyJQ(".version.id", "openshift-v4.8.1"),
-				RespondWithPatchedJSON(http.StatusOK, template, `[
-				  {
-				    "op": "replace",
-				    "path": "/version",
-				    "value": {
-				      "id": "openshift-v4.8.1"
-				    }
-				  }
-				]`),
+	It("Fails if a network CIDR is malformed", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    service_cidr   = "172.30.0.0/33"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid CIDR")
+	})
+
+	It("Fails if network CIDRs overlap", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    machine_cidr   = "10.0.0.0/8"
+		    service_cidr   = "172.30.0.0/16"
+		    pod_cidr       = "10.128.0.0/14"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("overlapping CIDRs")
+	})
+
+	It("Sets the availability zone of a single zone cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.multi_az`, false),
+				VerifyJQ(`.nodes.availability_zones`, []interface{}{"us-west-1a"}),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/nodes/availability_zones",
+				    "value": ["us-west-1a"]
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name               = "my-cluster"
+			product		       = "osd"
+		    cloud_provider     = "aws"
+		    cloud_region       = "us-west-1"
+		    multi_az           = false
+		    availability_zones = ["us-west-1a"]
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.availability_zones`, []interface{}{"us-west-1a"}))
+	})
+
+	It("Sets the availability zones of a multi zone cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.multi_az`, true),
+				VerifyJQ(`.nodes.availability_zones`, []interface{}{"us-west-1a", "us-west-1b", "us-west-1c"}),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/multi_az",
+				    "value": true
+				  },
+				  {
+				    "op": "replace",
+				    "path": "/nodes/availability_zones",
+				    "value": ["us-west-1a", "us-west-1b", "us-west-1c"]
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name               = "my-cluster"
+			product		       = "osd"
+		    cloud_provider     = "aws"
+		    cloud_region       = "us-west-1"
+		    multi_az           = true
+		    availability_zones = ["us-west-1a", "us-west-1b", "us-west-1c"]
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.multi_az`, true))
+		Expect(resource).To(MatchJQ(`.attributes.availability_zones`,
+			[]interface{}{"us-west-1a", "us-west-1b", "us-west-1c"}))
+	})
+
+	It("Fails if the number of availability zones doesn't match multi_az", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name               = "my-cluster"
+			product		       = "osd"
+		    cloud_provider     = "aws"
+		    cloud_region       = "us-west-1"
+		    multi_az           = true
+		    availability_zones = ["us-west-1a"]
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid number of availability zones")
+	})
+
+	It("Sets a custom domain prefix", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(".domain_prefix", "my-prefix"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/domain_prefix",
+				    "value": "my-prefix"
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    domain_prefix  = "my-prefix"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.domain_prefix", "my-prefix"))
+	})
+
+	It("Fails if the domain prefix isn't a valid DNS label", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    domain_prefix  = "My_Prefix"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid domain prefix")
+	})
+
+	It("Fails if the domain prefix is too long", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    domain_prefix  = "my-very-long-prefix"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid domain prefix")
+	})
+
+	It("Sets the hosted zone of a shared VPC", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(".aws.private_hosted_zone_id", "Z05646003S02O1ENCDCSN"),
+				VerifyJQ(".aws.private_hosted_zone_role_arn", "arn:aws:iam::111111111111:role/shared-vpc"),
+				VerifyJQ(".dns.base_domain", "example.com"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "add",
+				    "path": "/aws",
+				    "value": {
+				      "private_hosted_zone_id": "Z05646003S02O1ENCDCSN",
+				      "private_hosted_zone_role_arn": "arn:aws:iam::111111111111:role/shared-vpc"
+				    }
+				  },
+				  {
+				    "op": "add",
+				    "path": "/dns",
+				    "value": {
+				      "base_domain": "example.com"
+				    }
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    shared_vpc = {
+		      hosted_zone_id  = "Z05646003S02O1ENCDCSN"
+		      shared_role_arn = "arn:aws:iam::111111111111:role/shared-vpc"
+		      base_dns_domain = "example.com"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.shared_vpc.hosted_zone_id", "Z05646003S02O1ENCDCSN"))
+		Expect(resource).To(MatchJQ(".attributes.shared_vpc.shared_role_arn", "arn:aws:iam::111111111111:role/shared-vpc"))
+		Expect(resource).To(MatchJQ(".attributes.shared_vpc.base_dns_domain", "example.com"))
+	})
+
+	It("Fails if the shared VPC settings are incomplete", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    shared_vpc = {
+		      hosted_zone_id = "Z05646003S02O1ENCDCSN"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("incomplete shared VPC configuration")
+	})
+
+	It("Creates a GCP cluster with a project ID", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(".cloud_provider.id", "gcp"),
+				VerifyJQ(".gcp.project_id", "my-project"),
+				VerifyJQ(".gcp.client_email", "osd-ccs-admin@my-key-project.iam.gserviceaccount.com"),
+				VerifyJQ(".gcp.private_key_id", "my-key-id"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/cloud_provider",
+				    "value": {
+				      "id": "gcp"
+				    }
+				  },
+				  {
+				    "op": "replace",
+				    "path": "/region",
+				    "value": {
+				      "id": "us-east1"
+				    }
+				  },
+				  {
+				    "op": "add",
+				    "path": "/gcp",
+				    "value": {
+				      "project_id": "my-project"
+				    }
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                    = "my-cluster"
+			product		            = "osd"
+		    cloud_provider          = "gcp"
+		    cloud_region            = "us-east1"
+		    gcp_project_id          = "my-project"
+		    gcp_service_account_key = jsonencode({
+		      type           = "service_account"
+		      project_id     = "my-key-project"
+		      private_key_id = "my-key-id"
+		      private_key    = "my-private-key"
+		      client_email   = "osd-ccs-admin@my-key-project.iam.gserviceaccount.com"
+		      client_id      = "my-client-id"
+		    })
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.gcp_project_id", "my-project"))
+	})
+
+	It("Fails if a GCP attribute is set for an AWS cluster", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    gcp_project_id = "my-project"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("unexpected GCP attribute")
+	})
+
+	It("Fails if the GCP service account key isn't valid JSON", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                    = "my-cluster"
+			product		            = "osd"
+		    cloud_provider          = "gcp"
+		    cloud_region            = "us-east1"
+		    gcp_service_account_key = "not-json"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid GCP service account key")
+	})
+
+	It("Creates an Azure hosted control plane cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(".cloud_provider.id", "azure"),
+				VerifyJQ(".hypershift.enabled", true),
+				VerifyJQ(".azure.tenant_id", "my-tenant"),
+				VerifyJQ(".azure.subscription_id", "my-subscription"),
+				VerifyJQ(".azure.resource_group_name", "my-group"),
+				VerifyJQ(".azure.resource_name", "my-cluster"),
+				VerifyJQ(".azure.subnet_resource_id", "my-subnet"),
+				VerifyJQ(".azure.network_security_group_resource_id", "my-nsg"),
+				VerifyJQ(
+					".azure.operators_authentication.managed_identities.control_plane_operators_managed_identities.ingress.resource_id",
+					"my-ingress-identity",
+				),
+				VerifyJQ(
+					".azure.operators_authentication.managed_identities.service_managed_identity.resource_id",
+					"my-service-identity",
+				),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/cloud_provider",
+				    "value": {
+				      "id": "azure"
+				    }
+				  },
+				  {
+				    "op": "replace",
+				    "path": "/region",
+				    "value": {
+				      "id": "eastus"
+				    }
+				  },
+				  {
+				    "op": "add",
+				    "path": "/hypershift",
+				    "value": {
+				      "enabled": true
+				    }
+				  },
+				  {
+				    "op": "add",
+				    "path": "/azure",
+				    "value": {
+				      "tenant_id": "my-tenant",
+				      "subscription_id": "my-subscription",
+				      "resource_group_name": "my-group",
+				      "resource_name": "my-cluster",
+				      "managed_resource_group_name": "my-managed-group",
+				      "subnet_resource_id": "my-subnet",
+				      "network_security_group_resource_id": "my-nsg",
+				      "operators_authentication": {
+				        "managed_identities": {
+				          "control_plane_operators_managed_identities": {
+				            "ingress": {
+				              "resource_id": "my-ingress-identity"
+				            }
+				          },
+				          "service_managed_identity": {
+				            "resource_id": "my-service-identity"
+				          }
+				        }
+				      }
+				    }
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "azure"
+		    cloud_region   = "eastus"
+		    azure = {
+		      tenant_id                          = "my-tenant"
+		      subscription_id                    = "my-subscription"
+		      resource_group_name                = "my-group"
+		      resource_name                      = "my-cluster"
+		      subnet_resource_id                 = "my-subnet"
+		      network_security_group_resource_id = "my-nsg"
+		      control_plane_operators_managed_identities = {
+		        ingress = "my-ingress-identity"
+		      }
+		      service_managed_identity = "my-service-identity"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(".attributes.azure.tenant_id", "my-tenant"))
+		Expect(resource).To(MatchJQ(".attributes.azure.managed_resource_group_name", "my-managed-group"))
+		Expect(resource).To(MatchJQ(
+			".attributes.azure.control_plane_operators_managed_identities.ingress",
+			"my-ingress-identity",
+		))
+	})
+
+	It("Fails if the Azure settings are set for an AWS cluster", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    azure = {
+		      tenant_id                          = "my-tenant"
+		      subscription_id                    = "my-subscription"
+		      resource_group_name                = "my-group"
+		      resource_name                      = "my-cluster"
+		      subnet_resource_id                 = "my-subnet"
+		      network_security_group_resource_id = "my-nsg"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("unexpected Azure attribute")
+	})
+
+	It("Sets FIPS", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.fips`, true),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "add",
+				    "path": "/fips",
+				    "value": true
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    fips           = true
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.fips`, true))
+	})
+
+	It("Forces replacement when FIPS changes", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusCreated, template),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.fips`, false))
+
+		// Prepare the server for the refresh of the plan:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, template),
+			),
+		)
+
+		// Check that changing FIPS replaces the cluster:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    fips           = true
+		  }
+		`)
+		Terraform.AssertReplace("rhcs_cluster.my_cluster")
+	})
+
+	It("Sets etcd encryption with a customer KMS key", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.etcd_encryption`, true),
+				VerifyJQ(`.aws.etcd_encryption.kms_key_arn`, "arn:aws:kms:us-west-1:111111111111:key/abc"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "add",
+				    "path": "/etcd_encryption",
+				    "value": true
+				  },
+				  {
+				    "op": "add",
+				    "path": "/aws",
+				    "value": {
+				      "etcd_encryption": {
+				        "kms_key_arn": "arn:aws:kms:us-west-1:111111111111:key/abc"
+				      }
+				    }
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                        = "my-cluster"
+			product		                = "osd"
+		    cloud_provider              = "aws"
+		    cloud_region                = "us-west-1"
+		    etcd_encryption             = true
+		    etcd_encryption_kms_key_arn = "arn:aws:kms:us-west-1:111111111111:key/abc"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.etcd_encryption`, true))
+		Expect(resource).To(MatchJQ(`.attributes.etcd_encryption_kms_key_arn`,
+			"arn:aws:kms:us-west-1:111111111111:key/abc"))
+	})
+
+	It("Fails if etcd encryption is enabled without a KMS key", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name            = "my-cluster"
+			product		    = "osd"
+		    cloud_provider  = "aws"
+		    cloud_region    = "us-west-1"
+		    etcd_encryption = true
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("missing etcd encryption KMS key ARN")
+	})
+
+	It("Fails if a KMS key is given without etcd encryption", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name                        = "my-cluster"
+			product		                = "osd"
+		    cloud_provider              = "aws"
+		    cloud_region                = "us-west-1"
+		    etcd_encryption_kms_key_arn = "arn:aws:kms:us-west-1:111111111111:key/abc"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("unexpected etcd encryption KMS key ARN")
+	})
+
+	Context("Workload monitoring", func() {
+		It("Disables workload monitoring on creation", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					VerifyJQ(`.disable_user_workload_monitoring`, true),
+					RespondWithPatchedJSON(http.StatusCreated, template, `[
+					  {
+					    "op": "add",
+					    "path": "/disable_user_workload_monitoring",
+					    "value": true
+					  }
+					]`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name                        = "my-cluster"
+			    product                     = "osd"
+			    cloud_provider              = "aws"
+			    cloud_region                = "us-west-1"
+			    disable_workload_monitoring = true
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Check the state:
+			resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.disable_workload_monitoring`, true))
+
+			// Prepare the server for the refresh, the update shouldn't send any patch:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithPatchedJSON(http.StatusOK, template, `[
+					  {
+					    "op": "add",
+					    "path": "/disable_user_workload_monitoring",
+					    "value": true
+					  }
+					]`),
+				),
+			)
+
+			// Run the apply command again without changes:
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource = Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.disable_workload_monitoring`, true))
+		})
+
+		It("Enables and disables workload monitoring on update", func() {
+			// Prepare the server for the creation:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					RespondWithJSON(http.StatusCreated, template),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name           = "my-cluster"
+			    product        = "osd"
+			    cloud_provider = "aws"
+			    cloud_region   = "us-west-1"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.disable_workload_monitoring`, false))
+
+			// Prepare the server to disable it:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, template),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+					VerifyJQ(`.disable_user_workload_monitoring`, true),
+					RespondWithPatchedJSON(http.StatusOK, template, `[
+					  {
+					    "op": "add",
+					    "path": "/disable_user_workload_monitoring",
+					    "value": true
+					  }
+					]`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name                        = "my-cluster"
+			    product                     = "osd"
+			    cloud_provider              = "aws"
+			    cloud_region                = "us-west-1"
+			    disable_workload_monitoring = true
+			  }
+			`)
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource = Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.disable_workload_monitoring`, true))
+
+			// Prepare the server to enable it again:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithPatchedJSON(http.StatusOK, template, `[
+					  {
+					    "op": "add",
+					    "path": "/disable_user_workload_monitoring",
+					    "value": true
+					  }
+					]`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+					VerifyJQ(`.disable_user_workload_monitoring`, false),
+					RespondWithJSON(http.StatusOK, template),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name                        = "my-cluster"
+			    product                     = "osd"
+			    cloud_provider              = "aws"
+			    cloud_region                = "us-west-1"
+			    disable_workload_monitoring = false
+			  }
+			`)
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource = Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.disable_workload_monitoring`, false))
+		})
+	})
+
+	It("Creates a cluster admin user", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.htpasswd.users.items[0].username`, "cluster-admin"),
+				RespondWithJSON(http.StatusCreated, template),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name              = "my-cluster"
+			product		      = "osd"
+		    cloud_provider    = "aws"
+		    cloud_region      = "us-west-1"
+		    create_admin_user = true
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.admin_credentials.username`, "cluster-admin"))
+		Expect(resource).To(MatchJQ(`.attributes.admin_credentials.password | length > 0`, true))
+		Expect(resource).To(MatchJQ(
+			`[.sensitive_attributes[][] | select(.value == "admin_credentials")] | length > 0`, true,
+		))
+	})
+
+	It("Creates a cluster admin user with the given credentials", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.htpasswd.users.items[0].username`, "my-admin"),
+				VerifyJQ(`.htpasswd.users.items[0].hashed_password`, "hash(1234AbB2341234)"),
+				RespondWithJSON(http.StatusCreated, template),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name              = "my-cluster"
+			product		      = "osd"
+		    cloud_provider    = "aws"
+		    cloud_region      = "us-west-1"
+		    create_admin_user = true
+		    admin_username    = "my-admin"
+		    admin_password    = "1234AbB2341234"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.admin_credentials.username`, "my-admin"))
+		Expect(resource).To(MatchJQ(`.attributes.admin_credentials.password`, "1234AbB2341234"))
+	})
+
+	It("Generates the password of the cluster admin user when only the username is given", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.htpasswd.users.items[0].username`, "my-admin"),
+				RespondWithJSON(http.StatusCreated, template),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    admin_username = "my-admin"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.admin_credentials.username`, "my-admin"))
+		Expect(resource).To(MatchJQ(`.attributes.admin_credentials.password | length > 0`, true))
+	})
+
+	It("Doesn't create a cluster admin user by default", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.htpasswd`, nil),
+				RespondWithJSON(http.StatusCreated, template),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.admin_credentials`, nil))
+	})
+
+	It("Sets version", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(".version.id", "openshift-v4.8.1"),
+				RespondWithPatchedJSON(http.StatusOK, template, `[
+				  {
+				    "op": "replace",
+				    "path": "/version",
+				    "value": {
+				      "id": "openshift-v4.8.1"
+				    }
+				  }
+				]`),
 			),
 		)
 
@@ -293,6 +1596,377 @@ var _ = Describe("Cluster creation", func() {
 		Expect(resource).To(MatchJQ(".attributes.version", "openshift-v4.8.1"))
 	})
 
+	It("Sets default machine pool labels", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.nodes.compute_labels.label_key1`, "label_value1"),
+				VerifyJQ(`.nodes.compute_labels."example.com/role"`, "worker"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "add",
+				    "path": "/nodes/compute_labels",
+				    "value": {
+				      "label_key1": "label_value1",
+				      "example.com/role": "worker"
+				    }
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    default_machine_pool_labels = {
+		      "label_key1"       = "label_value1"
+		      "example.com/role" = "worker"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.default_machine_pool_labels.label_key1`, "label_value1"))
+		Expect(resource).To(MatchJQ(`.attributes.default_machine_pool_labels."example.com/role"`, "worker"))
+	})
+
+	It("Fails if a default machine pool label key is invalid", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    default_machine_pool_labels = {
+		      "invalid key!" = "value"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid label key")
+	})
+
+	It("Sets an internal API listening", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.api.listening`, "internal"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "add",
+				    "path": "/api/listening",
+				    "value": "internal"
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    api_listening  = "internal"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.api_listening`, "internal"))
+	})
+
+	It("Sets an external API listening", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+				VerifyJQ(`.api.listening`, "external"),
+				RespondWithPatchedJSON(http.StatusCreated, template, `[
+				  {
+				    "op": "add",
+				    "path": "/api/listening",
+				    "value": "external"
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name           = "my-cluster"
+			product		   = "osd"
+		    cloud_provider = "aws"
+		    cloud_region   = "us-west-1"
+		    api_listening  = "external"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+		Expect(resource).To(MatchJQ(`.attributes.api_listening`, "external"))
+	})
+
+	It("Fails if the API listening is external with private link", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster" "my_cluster" {
+		    name             = "my-cluster"
+			product		     = "osd"
+		    cloud_provider   = "aws"
+		    cloud_region     = "us-west-1"
+		    aws_private_link = true
+		    api_listening    = "external"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid api listening")
+	})
+
+	Context("Default ingress", func() {
+		const ingresses = `{
+		  "kind": "IngressList",
+		  "page": 1,
+		  "size": 1,
+		  "total": 1,
+		  "items": [
+		    {
+		      "kind": "Ingress",
+		      "id": "d6z2",
+		      "listening": "external",
+		      "default": true,
+		      "route_wildcard_policy": "WildcardsDisallowed",
+		      "route_namespace_ownership_policy": "Strict"
+		    }
+		  ]
+		}`
+
+		const ingress = `{
+		  "kind": "Ingress",
+		  "id": "d6z2",
+		  "listening": "external",
+		  "default": true,
+		  "route_selectors": {
+		    "route": "public"
+		  },
+		  "excluded_namespaces": ["stage"],
+		  "route_wildcard_policy": "WildcardsAllowed",
+		  "route_namespace_ownership_policy": "Strict"
+		}`
+
+		It("Sets the default ingress on creation", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					RespondWithJSON(http.StatusCreated, template),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+					RespondWithJSON(http.StatusOK, ingresses),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123/ingresses/d6z2"),
+					VerifyJQ(`.route_selectors.route`, "public"),
+					VerifyJQ(`.excluded_namespaces`, []interface{}{"stage"}),
+					VerifyJQ(`.route_wildcard_policy`, "WildcardsAllowed"),
+					VerifyJQ(`.route_namespace_ownership_policy`, nil),
+					VerifyJQ(`.listening`, nil),
+					RespondWithJSON(http.StatusOK, ingress),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name           = "my-cluster"
+			    product        = "osd"
+			    cloud_provider = "aws"
+			    cloud_region   = "us-west-1"
+			    default_ingress = {
+			      route_selectors       = { "route" = "public" }
+			      excluded_namespaces   = ["stage"]
+			      route_wildcard_policy = "WildcardsAllowed"
+			    }
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Check the state:
+			resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.listening_method`, "external"))
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.route_selectors.route`, "public"))
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.excluded_namespaces`, []interface{}{"stage"}))
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.route_wildcard_policy`, "WildcardsAllowed"))
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.route_namespace_ownership_policy`, "Strict"))
+		})
+
+		It("Updates the default ingress", func() {
+			// Prepare the server for the creation:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					RespondWithJSON(http.StatusCreated, template),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+					RespondWithJSON(http.StatusOK, ingresses),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name           = "my-cluster"
+			    product        = "osd"
+			    cloud_provider = "aws"
+			    cloud_region   = "us-west-1"
+			    default_ingress = {}
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.route_wildcard_policy`, "WildcardsDisallowed"))
+
+			// Prepare the server for the update:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, template),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+					RespondWithJSON(http.StatusOK, ingresses),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, template),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+					RespondWithJSON(http.StatusOK, ingresses),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123/ingresses/d6z2"),
+					VerifyJQ(`.route_selectors.route`, "public"),
+					VerifyJQ(`.excluded_namespaces`, []interface{}{"stage"}),
+					VerifyJQ(`.route_wildcard_policy`, "WildcardsAllowed"),
+					RespondWithJSON(http.StatusOK, ingress),
+				),
+			)
+
+			// Run the apply command again:
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name           = "my-cluster"
+			    product        = "osd"
+			    cloud_provider = "aws"
+			    cloud_region   = "us-west-1"
+			    default_ingress = {
+			      route_selectors       = { "route" = "public" }
+			      excluded_namespaces   = ["stage"]
+			      route_wildcard_policy = "WildcardsAllowed"
+			    }
+			  }
+			`)
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Check the state:
+			resource = Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.route_selectors.route`, "public"))
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.excluded_namespaces`, []interface{}{"stage"}))
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress.route_wildcard_policy`, "WildcardsAllowed"))
+		})
+
+		It("Removes the default ingress from the state if it doesn't exist", func() {
+			// Prepare the server for the creation:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					RespondWithJSON(http.StatusCreated, template),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+					RespondWithJSON(http.StatusOK, ingresses),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name           = "my-cluster"
+			    product        = "osd"
+			    cloud_provider = "aws"
+			    cloud_region   = "us-west-1"
+			    default_ingress = {}
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Prepare the server for the refresh:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, template),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "IngressList",
+					  "page": 1,
+					  "size": 0,
+					  "total": 0,
+					  "items": []
+					}`),
+				),
+			)
+
+			// Refresh the state:
+			runOutput = Terraform.Run("apply", "-refresh-only", "-auto-approve", "-no-color")
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Check the state:
+			resource := Terraform.Resource("rhcs_cluster", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.default_ingress`, nil))
+		})
+
+		It("Fails if the default ingress is set without waiting for the cluster", func() {
+			Terraform.Source(`
+			  resource "rhcs_cluster" "my_cluster" {
+			    name           = "my-cluster"
+			    product        = "osd"
+			    cloud_provider = "aws"
+			    cloud_region   = "us-west-1"
+			    wait           = false
+			    default_ingress = {}
+			  }
+			`)
+			runOutput := Terraform.Validate()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Attribute 'default_ingress' requires 'wait' to be enabled")
+		})
+	})
+
 	It("Fails if the cluster already exists", func() {
 		// Prepare the server:
 		TestServer.AppendHandlers(
@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Default machine pool", func() {
+	const pool = `{
+	  "id": "worker",
+	  "kind": "MachinePool",
+	  "href": "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker",
+	  "replicas": 2,
+	  "instance_type": "r5.xlarge",
+	  "labels": {
+	    "team": "a"
+	  }
+	}`
+
+	BeforeEach(func() {
+		// The provider checks that the cluster is ready before adopting the pool:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "state": "ready"
+				}`),
+			),
+		)
+	})
+
+	// adopt prepares the server and applies a configuration that adopts the default machine
+	// pool without changing it.
+	adopt := func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"),
+				RespondWithJSON(http.StatusOK, pool),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"),
+				VerifyJSON(`{
+				  "kind": "MachinePool",
+				  "id": "worker",
+				  "replicas": 2,
+				  "labels": {
+				    "team": "a"
+				  }
+				}`),
+				RespondWithJSON(http.StatusOK, pool),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_default_machine_pool" "worker" {
+		    cluster = "123"
+		    labels  = { "team" = "a" }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	}
+
+	It("Adopts the default machine pool and updates it", func() {
+		adopt()
+		resource := Terraform.Resource("rhcs_default_machine_pool", "worker")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "worker"))
+		Expect(resource).To(MatchJQ(`.attributes.replicas`, 2.0))
+		Expect(resource).To(MatchJQ(`.attributes.autoscaling_enabled`, false))
+		Expect(resource).To(MatchJQ(`.attributes.labels.team`, "a"))
+
+		// Prepare the server for the update:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"),
+				RespondWithJSON(http.StatusOK, pool),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"),
+				RespondWithJSON(http.StatusOK, pool),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"),
+				VerifyJSON(`{
+				  "kind": "MachinePool",
+				  "id": "worker",
+				  "autoscaling": {
+				    "kind": "MachinePoolAutoscaling",
+				    "min_replicas": 3,
+				    "max_replicas": 6
+				  },
+				  "labels": {
+				    "team": "b"
+				  }
+				}`),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "worker",
+				  "kind": "MachinePool",
+				  "href": "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker",
+				  "autoscaling": {
+				    "min_replicas": 3,
+				    "max_replicas": 6
+				  },
+				  "instance_type": "r5.xlarge",
+				  "labels": {
+				    "team": "b"
+				  }
+				}`),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_default_machine_pool" "worker" {
+		    cluster             = "123"
+		    autoscaling_enabled = true
+		    min_replicas        = 3
+		    max_replicas        = 6
+		    labels              = { "team" = "b" }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource = Terraform.Resource("rhcs_default_machine_pool", "worker")
+		Expect(resource).To(MatchJQ(`.attributes.autoscaling_enabled`, true))
+		Expect(resource).To(MatchJQ(`.attributes.min_replicas`, 3.0))
+		Expect(resource).To(MatchJQ(`.attributes.max_replicas`, 6.0))
+		Expect(resource).To(MatchJQ(`.attributes.replicas`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.labels.team`, "b"))
+	})
+
+	It("Fails to adopt the default machine pool with replicas and autoscaling", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"),
+				RespondWithJSON(http.StatusOK, pool),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_default_machine_pool" "worker" {
+		    cluster             = "123"
+		    replicas            = 3
+		    autoscaling_enabled = true
+		    min_replicas        = 3
+		    max_replicas        = 6
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("when enabling autoscaling, cannot set replicas")
+	})
+
+	It("Doesn't delete the default machine pool on destroy", func() {
+		adopt()
+
+		// Only the refresh is expected, a DELETE request would fail the test as no handler
+		// is prepared for it:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"),
+				RespondWithJSON(http.StatusOK, pool),
+			),
+		)
+		runOutput := Terraform.Destroy()
+		Expect(runOutput.ExitCode).To(BeZero())
+		Expect(TestServer.ReceivedRequests()).ToNot(ContainElement(
+			WithTransform(func(r *http.Request) string { return r.Method }, Equal(http.MethodDelete)),
+		))
+	})
+})
@@ -79,6 +79,46 @@ var _ = Describe("Group membership creation", func() {
 		runOutput.VerifyErrorContainsSubstring(`Attribute cluster cluster ID may not be empty/blank string`)
 	})
 
+	It("Applies only the targeted group membership", func() {
+		// Prepare the server, only the targeted membership should be created:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(
+					http.MethodPost,
+					"/api/clusters_mgmt/v1/clusters/123/groups/dedicated-admins/users",
+				),
+				VerifyJQ(`.id`, "my-admin"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "my-admin"
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_group_membership" "my_membership" {
+		    cluster   = "123"
+		    group     = "dedicated-admins"
+		    user      = "my-admin"
+		  }
+
+		  resource "rhcs_group_membership" "other_membership" {
+		    cluster   = "123"
+		    group     = "dedicated-admins"
+		    user      = "other-admin"
+		  }
+		`)
+		runOutput := Terraform.Target("rhcs_group_membership.my_membership").Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_group_membership", "my_membership")
+		Expect(resource).To(MatchJQ(".attributes.user", "my-admin"))
+		Expect(Terraform.State()).To(MatchJQ(
+			`[.resources[] | select(.name == "other_membership")] | length`, 0,
+		))
+	})
+
 	It("Can create a group membership", func() {
 		// Prepare the server:
 		TestServer.AppendHandlers(
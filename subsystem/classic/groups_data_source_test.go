@@ -17,7 +17,9 @@ limitations under the License.
 package classic
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
 	. "github.com/onsi/gomega"                         // nolint
@@ -75,4 +77,55 @@ var _ = Describe("Groups data source", func() {
 		Expect(resource).To(MatchJQ(`.attributes.items[1].id`, "dedicated-admins2"))
 		Expect(resource).To(MatchJQ(`.attributes.items[1].name`, "dedicated-admins2"))
 	})
+
+	It("Can list groups across multiple pages", func() {
+		// groupsPage returns a page of groups with the given identifiers:
+		groupsPage := func(page, total int, ids ...string) string {
+			items := make([]string, len(ids))
+			for i, id := range ids {
+				items[i] = fmt.Sprintf(`{"id": "%s"}`, id)
+			}
+			return fmt.Sprintf(`{
+			  "page": %d,
+			  "size": %d,
+			  "total": %d,
+			  "items": [%s]
+			}`, page, len(ids), total, strings.Join(items, ", "))
+		}
+		firstPage := make([]string, 10)
+		for i := range firstPage {
+			firstPage[i] = fmt.Sprintf("group-%d", i+1)
+		}
+
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/groups"),
+				VerifyFormKV("size", "10"),
+				RespondWithJSON(http.StatusOK, groupsPage(1, 11, firstPage...)),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/groups"),
+				VerifyFormKV("page", "2"),
+				VerifyFormKV("size", "10"),
+				RespondWithJSON(http.StatusOK, groupsPage(2, 11, "group-11")),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_groups" "my_groups" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_groups", "my_groups")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 11))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "group-1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[9].id`, "group-10"))
+		Expect(resource).To(MatchJQ(`.attributes.items[10].id`, "group-11"))
+	})
 })
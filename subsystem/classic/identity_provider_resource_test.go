@@ -1479,6 +1479,68 @@ var _ = Describe("Identity provider import", func() {
 		Expect(resource).To(MatchJQ(".attributes.github.client_id", "99999"))
 	})
 
+	It("Can import an identity provider by ID", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, template),
+			),
+			// List IDPs to find the ID:
+			CombineHandlers(
+				VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/identity_providers",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "IdentityProviderList",
+					"href": "/api/clusters_mgmt/v1/clusters/123/identity_providers",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [
+						{
+						"kind": "IdentityProvider",
+						"type": "HTPasswdIdentityProvider",
+						"href": "/api/clusters_mgmt/v1/clusters/123/identity_providers/24vgs9hgnl5bukujvkcmgkvfgc01ss0r",
+						"id": "24vgs9hgnl5bukujvkcmgkvfgc01ss0r",
+						"name": "my-ip",
+						"mapping_method": "claim",
+						"htpasswd": {}
+						}
+					]
+				}`),
+			),
+			// Read the IDP to load the current state:
+			CombineHandlers(
+				VerifyRequest(
+					http.MethodGet,
+					"/api/clusters_mgmt/v1/clusters/123/identity_providers/24vgs9hgnl5bukujvkcmgkvfgc01ss0r",
+				),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "IdentityProvider",
+					"type": "HTPasswdIdentityProvider",
+					"href": "/api/clusters_mgmt/v1/clusters/123/identity_providers/24vgs9hgnl5bukujvkcmgkvfgc01ss0r",
+					"id": "24vgs9hgnl5bukujvkcmgkvfgc01ss0r",
+					"name": "my-ip",
+					"mapping_method": "claim",
+					"htpasswd": {}
+				}`),
+			),
+		)
+
+		Terraform.Source(`
+			resource "rhcs_identity_provider" "my-ip" {
+				# (resource arguments)
+			}
+		`)
+		runOutput := Terraform.Import("rhcs_identity_provider.my-ip", "123,24vgs9hgnl5bukujvkcmgkvfgc01ss0r")
+		Expect(runOutput.ExitCode).To(BeZero())
+		resource := Terraform.Resource("rhcs_identity_provider", "my-ip")
+		Expect(resource).To(MatchJQ(".attributes.id", "24vgs9hgnl5bukujvkcmgkvfgc01ss0r"))
+		Expect(resource).To(MatchJQ(".attributes.name", "my-ip"))
+	})
+
 	It("Is an error if the identity provider isn't found", func() {
 		// Prepare the server:
 		TestServer.AppendHandlers(
@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Ingress creation", func() {
+	const ingress = `{
+	  "kind": "Ingress",
+	  "href": "/api/clusters_mgmt/v1/clusters/123/ingresses/a1b2",
+	  "id": "a1b2",
+	  "listening": "internal",
+	  "default": false,
+	  "dns_name": "apps2.redhat.com",
+	  "load_balancer_type": "nlb",
+	  "route_selectors": {
+	    "route": "private"
+	  }
+	}`
+
+	BeforeEach(func() {
+		// The provider checks that the cluster is ready before creating the ingress:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "state": "ready"
+				}`),
+			),
+		)
+	})
+
+	It("Fails if the listening method is invalid", func() {
+		Terraform.Source(`
+		  resource "rhcs_ingress" "my_ingress" {
+		    cluster   = "123"
+		    listening = "private"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Expected a valid param")
+	})
+
+	It("Creates a second internal ingress", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+				VerifyJQ(`.default`, false),
+				VerifyJQ(`.listening`, "internal"),
+				VerifyJQ(`.route_selectors.route`, "private"),
+				VerifyJQ(`.load_balancer_type`, "nlb"),
+				RespondWithJSON(http.StatusCreated, ingress),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_ingress" "my_ingress" {
+		    cluster            = "123"
+		    listening          = "internal"
+		    route_selectors    = { "route" = "private" }
+		    load_balancer_type = "nlb"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_ingress", "my_ingress")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "a1b2"))
+		Expect(resource).To(MatchJQ(`.attributes.listening`, "internal"))
+		Expect(resource).To(MatchJQ(`.attributes.route_selectors.route`, "private"))
+		Expect(resource).To(MatchJQ(`.attributes.load_balancer_type`, "nlb"))
+		Expect(resource).To(MatchJQ(`.attributes.dns_name`, "apps2.redhat.com"))
+	})
+
+	It("Updates the route selectors of the ingress", func() {
+		// Prepare the server for the creation:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+				RespondWithJSON(http.StatusCreated, ingress),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_ingress" "my_ingress" {
+		    cluster            = "123"
+		    listening          = "internal"
+		    route_selectors    = { "route" = "private" }
+		    load_balancer_type = "nlb"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Prepare the server for the update. The cluster readiness handler prepared in
+		// BeforeEach has already been consumed, so the refresh starts with the ingress:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses/a1b2"),
+				RespondWithJSON(http.StatusOK, ingress),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123/ingresses/a1b2"),
+				VerifyJQ(`.route_selectors`, map[string]interface{}{"route": "internal", "team": "a"}),
+				VerifyJQ(`.listening`, nil),
+				VerifyJQ(`.load_balancer_type`, nil),
+				RespondWithPatchedJSON(http.StatusOK, ingress, `[
+				  {
+				    "op": "replace",
+				    "path": "/route_selectors",
+				    "value": {
+				      "route": "internal",
+				      "team": "a"
+				    }
+				  }
+				]`),
+			),
+		)
+
+		// Run the apply command again:
+		Terraform.Source(`
+		  resource "rhcs_ingress" "my_ingress" {
+		    cluster            = "123"
+		    listening          = "internal"
+		    route_selectors    = { "route" = "internal", "team" = "a" }
+		    load_balancer_type = "nlb"
+		  }
+		`)
+		runOutput = Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_ingress", "my_ingress")
+		Expect(resource).To(MatchJQ(`.attributes.route_selectors.route`, "internal"))
+		Expect(resource).To(MatchJQ(`.attributes.route_selectors.team`, "a"))
+	})
+
+	It("Deletes the ingress", func() {
+		// Prepare the server for the creation:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+				RespondWithJSON(http.StatusCreated, ingress),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_ingress" "my_ingress" {
+		    cluster            = "123"
+		    listening          = "internal"
+		    route_selectors    = { "route" = "private" }
+		    load_balancer_type = "nlb"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Prepare the server for the deletion:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses/a1b2"),
+				RespondWithJSON(http.StatusOK, ingress),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123/ingresses/a1b2"),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+		)
+		runOutput = Terraform.Destroy()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Removes the ingress from the state when it was already deleted", func() {
+		// Prepare the server for the creation:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/ingresses"),
+				RespondWithJSON(http.StatusCreated, ingress),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  resource "rhcs_ingress" "my_ingress" {
+		    cluster            = "123"
+		    listening          = "internal"
+		    route_selectors    = { "route" = "private" }
+		    load_balancer_type = "nlb"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Prepare the server for the deletion:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/ingresses/a1b2"),
+				RespondWithJSON(http.StatusOK, ingress),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123/ingresses/a1b2"),
+				RespondWithJSON(http.StatusNotFound, `{
+				  "kind": "Error",
+				  "id": "404",
+				  "reason": "Ingress 'a1b2' not found"
+				}`),
+			),
+		)
+		runOutput = Terraform.Destroy()
+		Expect(runOutput.ExitCode).To(BeZero())
+		Expect(Terraform.ResourceCount("rhcs_ingress")).To(BeZero())
+	})
+})
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Machine pool data source", func() {
+	BeforeEach(func() {
+		// The machine pool is read after the cluster:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "multi_az": false,
+				  "nodes": {
+				    "availability_zones": [
+				      "us-east-1a"
+				    ]
+				  },
+				  "state": "ready"
+				}`),
+			),
+		)
+	})
+
+	It("Can read a machine pool by name", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/my-pool"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "my-pool",
+				  "kind": "MachinePool",
+				  "href": "/api/clusters_mgmt/v1/clusters/123/machine_pools/my-pool",
+				  "instance_type": "r5.xlarge",
+				  "autoscaling": {
+				    "min_replicas": 2,
+				    "max_replicas": 6
+				  },
+				  "labels": {
+				    "label_key1": "label_value1"
+				  },
+				  "taints": [
+				    {
+				      "effect": "NoSchedule",
+				      "key": "key1",
+				      "value": "value1"
+				    }
+				  ],
+				  "availability_zones": [
+				    "us-east-1a"
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_machine_pool" "my_pool" {
+		    cluster = "123"
+		    name    = "my-pool"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_machine_pool", "my_pool")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "my-pool"))
+		Expect(resource).To(MatchJQ(`.attributes.name`, "my-pool"))
+		Expect(resource).To(MatchJQ(`.attributes.machine_type`, "r5.xlarge"))
+		Expect(resource).To(MatchJQ(`.attributes.autoscaling_enabled`, true))
+		Expect(resource).To(MatchJQ(`.attributes.min_replicas`, 2.0))
+		Expect(resource).To(MatchJQ(`.attributes.max_replicas`, 6.0))
+		Expect(resource).To(MatchJQ(`.attributes.labels.label_key1`, "label_value1"))
+		Expect(resource).To(MatchJQ(`.attributes.taints[0].key`, "key1"))
+		Expect(resource).To(MatchJQ(`.attributes.taints[0].schedule_type`, "NoSchedule"))
+	})
+
+	It("Fails if the machine pool doesn't exist", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/my-pool"),
+				RespondWithJSON(http.StatusNotFound, `{
+				  "kind": "Error",
+				  "id": "404",
+				  "href": "/api/clusters_mgmt/v1/errors/404",
+				  "code": "CLUSTERS-MGMT-404",
+				  "reason": "Machine pool 'my-pool' not found"
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_machine_pool" "my_pool" {
+		    cluster = "123"
+		    name    = "my-pool"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Failed to find machine pool with identifier my-pool for cluster 123")
+	})
+})
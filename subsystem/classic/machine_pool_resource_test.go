@@ -220,6 +220,63 @@ var _ = Describe("Classic Machine Pool", func() {
 			Expect(resource).To(MatchJQ(`.attributes.labels | length`, 2))
 		})
 
+		It("Can create two machine pools", func() {
+			// The second pool depends on the first one so that the requests are sent in order:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/machine_pools",
+					),
+					VerifyJQ(".id", "my-pool-1"),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "my-pool-1",
+					  "instance_type": "r5.xlarge",
+					  "replicas": 3
+					}`),
+				),
+			)
+			prepareClusterRead("123")
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/machine_pools",
+					),
+					VerifyJQ(".id", "my-pool-2"),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "my-pool-2",
+					  "instance_type": "r5.xlarge",
+					  "replicas": 3
+					}`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_machine_pool" "my_pool_1" {
+				cluster      = "123"
+				name         = "my-pool-1"
+				machine_type = "r5.xlarge"
+				replicas     = 3
+			  }
+
+			  resource "rhcs_machine_pool" "my_pool_2" {
+				cluster      = "123"
+				name         = "my-pool-2"
+				machine_type = "r5.xlarge"
+				replicas     = 3
+				depends_on   = [rhcs_machine_pool.my_pool_1]
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Check the state:
+			Expect(Terraform.ResourceCount("rhcs_machine_pool")).To(Equal(2))
+			Expect(Terraform.ResourceCount("rhcs_hcp_machine_pool")).To(BeZero())
+		})
+
 		It("Can create machine pool with compute nodes when 404 (not found)", func() {
 			// Prepare the server:
 			TestServer.AppendHandlers(
@@ -457,6 +514,9 @@ var _ = Describe("Classic Machine Pool", func() {
 			Expect(resource).To(MatchJQ(".attributes.machine_type", "r5.xlarge"))
 			Expect(resource).To(MatchJQ(".attributes.replicas", 12.0))
 			Expect(resource).To(MatchJQ(`.attributes.labels | length`, 2))
+			serial, lineage, tfVersion := Terraform.StateMeta()
+			Expect(lineage).ToNot(BeEmpty())
+			Expect(tfVersion).ToNot(BeEmpty())
 
 			// Update - change lables
 			prepareClusterRead("123")
@@ -560,6 +620,9 @@ var _ = Describe("Classic Machine Pool", func() {
 			Expect(resource).To(MatchJQ(".attributes.machine_type", "r5.xlarge"))
 			Expect(resource).To(MatchJQ(".attributes.replicas", 12.0))
 			Expect(resource).To(MatchJQ(`.attributes.labels | length`, 1))
+			newSerial, newLineage, _ := Terraform.StateMeta()
+			Expect(newSerial).To(BeNumerically(">", serial))
+			Expect(newLineage).To(Equal(lineage))
 
 			// Update - delete lables
 			prepareClusterRead("123")
@@ -2358,13 +2421,56 @@ var _ = Describe("Classic Machine Pool", func() {
 				),
 			)
 		}
+		prepareVPCRead := func(clusterId string) {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/"+clusterId+"/vpc"),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "vpc-1",
+					  "aws_subnets": [
+						{
+						  "subnet_id": "id1",
+						  "availability_zone": "us-east-1a"
+						},
+						{
+						  "subnet_id": "id2",
+						  "availability_zone": "us-east-1a"
+						}
+					  ]
+					}`),
+				),
+			)
+		}
 		BeforeEach(func() {
+			// The subnet of the pool is checked against the VPC of the cluster when the plan
+			// is computed, and again when Terraform computes it before applying it:
+			prepareClusterRead("123")
+			prepareVPCRead("123")
+			prepareClusterRead("123")
+			prepareVPCRead("123")
 			// The first thing that the provider will do for any operation on machine pools
 			// is check that the cluster is ready, so we always need to prepare the server to
 			// respond to that:
 			prepareClusterRead("123")
 		})
 
+		It("Fails to plan pool w/ subnet_id outside of the VPC of the cluster", func() {
+			// Run the apply command:
+			Terraform.Source(`
+			  resource "rhcs_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				machine_type = "r5.xlarge"
+				replicas     = 4
+				subnet_id    = "id3"
+			  }
+			`)
+			runOutput := Terraform.Plan()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid subnet")
+			runOutput.VerifyErrorContainsSubstring("vpc-1")
+		})
+
 		It("Can create pool w/ subnet_id for byo vpc", func() {
 			// Prepare the server:
 			TestServer.AppendHandlers(
@@ -2503,6 +2609,8 @@ var _ = Describe("Classic Machine Pool", func() {
 			)
 		}
 		It("Can import a machine pool", func() {
+			// First get is for the import, to check the type of cluster:
+			prepareClusterRead("123")
 			prepareClusterRead("123")
 			// Prepare the server:
 			TestServer.AppendHandlers(
@@ -2535,6 +2643,29 @@ var _ = Describe("Classic Machine Pool", func() {
 			Expect(resource).To(MatchJQ(".attributes.name", "my-pool"))
 			Expect(resource).To(MatchJQ(".attributes.id", "my-pool"))
 		})
+
+		It("Fails to import a machine pool of a hosted control plane cluster", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "123",
+					  "name": "my-cluster",
+					  "state": "ready",
+					  "hypershift": {
+						"enabled": true
+					  }
+					}`),
+				),
+			)
+
+			Terraform.Source(`
+			  resource "rhcs_machine_pool" "my_pool" { }
+			`)
+			runOutput := Terraform.Import("rhcs_machine_pool.my_pool", "123,my-pool")
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("is a hosted control plane cluster")
+		})
 	})
 
 	Context("Machine pool creation for non exist cluster", func() {
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Machine type lookup data source", func() {
+	// Machine types returned by the server, in no particular order:
+	const machineTypes = `{
+	  "page": 1,
+	  "size": 5,
+	  "total": 5,
+	  "items": [
+	    {
+	      "id": "m5.2xlarge",
+	      "name": "m5.2xlarge - General purpose",
+	      "memory": {
+	        "value": 32,
+	        "unit": "GiB"
+	      },
+	      "cpu": {
+	        "value": 8,
+	        "unit": "vCPU"
+	      },
+	      "cloud_provider": {
+	        "id": "aws"
+	      }
+	    },
+	    {
+	      "id": "r5.xlarge",
+	      "name": "r5.xlarge - Memory optimized",
+	      "memory": {
+	        "value": 32,
+	        "unit": "GiB"
+	      },
+	      "cpu": {
+	        "value": 4,
+	        "unit": "vCPU"
+	      },
+	      "cloud_provider": {
+	        "id": "aws"
+	      }
+	    },
+	    {
+	      "id": "m5.xlarge",
+	      "name": "m5.xlarge - General purpose",
+	      "memory": {
+	        "value": 16,
+	        "unit": "GiB"
+	      },
+	      "cpu": {
+	        "value": 4,
+	        "unit": "vCPU"
+	      },
+	      "cloud_provider": {
+	        "id": "aws"
+	      }
+	    },
+	    {
+	      "id": "c5.xlarge",
+	      "name": "c5.xlarge - Compute optimized",
+	      "memory": {
+	        "value": 8,
+	        "unit": "GiB"
+	      },
+	      "cpu": {
+	        "value": 4,
+	        "unit": "vCPU"
+	      },
+	      "cloud_provider": {
+	        "id": "aws"
+	      }
+	    },
+	    {
+	      "id": "custom-4-16384",
+	      "name": "custom-4-16384 - General purpose",
+	      "memory": {
+	        "value": 16,
+	        "unit": "GiB"
+	      },
+	      "cpu": {
+	        "value": 4,
+	        "unit": "vCPU"
+	      },
+	      "cloud_provider": {
+	        "id": "gcp"
+	      }
+	    }
+	  ]
+	}`
+
+	BeforeEach(func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/machine_types"),
+				RespondWithJSON(http.StatusOK, machineTypes),
+			),
+		)
+	})
+
+	It("Selects the smallest machine type that satisfies the requirements", func() {
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_machine_type_lookup" "my_machine_type" {
+		    min_cpu = 4
+		    min_ram = 17179869184
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_machine_type_lookup", "my_machine_type")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "m5.xlarge"))
+		Expect(resource).To(MatchJQ(`.attributes.name`, "m5.xlarge - General purpose"))
+		Expect(resource).To(MatchJQ(`.attributes.cpu`, 4.0))
+		Expect(resource).To(MatchJQ(`.attributes.ram`, 17179869184.0))
+	})
+
+	It("Prefers less CPU over less RAM", func() {
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_machine_type_lookup" "my_machine_type" {
+		    min_cpu = 2
+		    min_ram = 20000000000
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_machine_type_lookup", "my_machine_type")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "r5.xlarge"))
+	})
+
+	It("Filters by cloud provider", func() {
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_machine_type_lookup" "my_machine_type" {
+		    cloud_provider = "gcp"
+		    min_cpu        = 2
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_machine_type_lookup", "my_machine_type")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "custom-4-16384"))
+	})
+
+	It("Fails if no machine type satisfies the requirements", func() {
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_machine_type_lookup" "my_machine_type" {
+		    min_cpu = 16
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Can't find machine type")
+	})
+})
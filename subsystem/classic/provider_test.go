@@ -0,0 +1,568 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Provider authentication", func() {
+	It("Authenticates with the client credentials of a service account", func() {
+		// Prepare the server:
+		accessToken := MakeTokenString("Bearer", 10*time.Minute)
+		TestServer.RouteToHandler(http.MethodPost, "/auth/token",
+			CombineHandlers(
+				VerifyBasicAuth("my-client", "my-secret"),
+				VerifyFormKV("grant_type", "client_credentials"),
+				VerifyFormKV("client_id", "my-client"),
+				RespondWithJSON(http.StatusOK, fmt.Sprintf(`{
+				  "access_token": "%s",
+				  "token_type": "Bearer",
+				  "expires_in": 600
+				}`, accessToken)),
+			),
+		)
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				VerifyHeaderKV("Authorization", "Bearer "+accessToken),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command with a runner that uses the client credentials:
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			TokenURL(TestServer.URL()+"/auth/token").
+			ClientCredentials("my-client", "my-secret").
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Fails if a token is set together with the client credentials", func() {
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			ClientCredentials("my-client", "my-secret").
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("conflicting authentication settings")
+	})
+})
+
+var _ = Describe("Provider request timeout", func() {
+	It("Fails if the server doesn't respond in time", func() {
+		// Prepare a server that takes longer to respond than the timeout:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				func(w http.ResponseWriter, r *http.Request) {
+					time.Sleep(3 * time.Second)
+				},
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command with a runner that times out after one second and doesn't retry:
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			Timeout(1).
+			Retries(0).
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("context deadline exceeded")
+	})
+})
+
+var _ = Describe("Provider user agent", func() {
+	It("Adds the prefix to the user agent of the requests", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				func(w http.ResponseWriter, r *http.Request) {
+					Expect(r.UserAgent()).To(HavePrefix("ci-job-1234 OCM-TF/"))
+				},
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command with a runner that sets the prefix:
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			UserAgentPrefix("ci-job-1234").
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+})
+
+var _ = Describe("Provider plugin cache", func() {
+	It("Reuses the cached provider in new runners", func() {
+		if os.Getenv("RHCS_DEV_OVERRIDE") != "" {
+			Skip("The provider isn't installed in the cache when using a development override")
+		}
+
+		// The runner of the test has already installed the provider in the cache:
+		cached, err := filepath.Glob(filepath.Join(pluginCacheDir, "terraform.local", "local", "rhcs", "*"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cached).To(HaveLen(1))
+		before, err := os.Stat(cached[0])
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check that initializing another runner doesn't install it again:
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			PluginCacheDir(pluginCacheDir).
+			Build()
+		defer runner.Close()
+		after, err := os.Stat(cached[0])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after.ModTime()).To(Equal(before.ModTime()))
+	})
+})
+
+var _ = Describe("Provider version", func() {
+	It("Installs the pinned version of the provider", func() {
+		if os.Getenv("RHCS_DEV_OVERRIDE") != "" {
+			Skip("The provider isn't installed when using a development override")
+		}
+
+		// Find the version of the provider installed by the make file for this platform:
+		home, err := os.UserHomeDir()
+		Expect(err).ToNot(HaveOccurred())
+		platform := runtime.GOOS + "_" + runtime.GOARCH
+		dirs, err := filepath.Glob(filepath.Join(home, ".terraform.d", "plugins", "terraform.local", "local", "rhcs", "*", platform))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dirs).ToNot(BeEmpty())
+		version := filepath.Base(filepath.Dir(dirs[0]))
+
+		// Check that the init command of the runner installed that version:
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			ProviderVersion("= " + version).
+			Build()
+		defer runner.Close()
+		runOutput := runner.Run("version")
+		Expect(runOutput.ExitCode).To(BeZero())
+		runOutput.VerifyOutputContainsSubstring("provider terraform.local/local/rhcs v" + version)
+	})
+})
+
+var _ = Describe("Provider dev override", func() {
+	It("Uses the local provider binary without installing it", func() {
+		// Find the provider binary, either the one of the development override or the one
+		// installed by the make file:
+		dir := os.Getenv("RHCS_DEV_OVERRIDE")
+		if dir == "" {
+			home, err := os.UserHomeDir()
+			Expect(err).ToNot(HaveOccurred())
+			dirs, err := filepath.Glob(filepath.Join(home, ".terraform.d", "plugins", "terraform.local", "local", "rhcs", "*", "*"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dirs).ToNot(BeEmpty())
+			dir = dirs[0]
+		}
+
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command with a runner that overrides the provider and whose plugin
+		// cache would receive any installed provider:
+		cacheDir, err := os.MkdirTemp("", "rhcs-plugin-cache-*.d")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(cacheDir)
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			PluginCacheDir(cacheDir).
+			DevOverride(dir).
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+		runOutput.VerifyOutputContainsSubstring("Provider development overrides are in effect")
+
+		// Check that no provider was installed:
+		cached, err := os.ReadDir(cacheDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cached).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Provider token file", func() {
+	var tokenFile string
+
+	BeforeEach(func() {
+		file, err := os.CreateTemp("", "rhcs-token-*")
+		Expect(err).ToNot(HaveOccurred())
+		tokenFile = file.Name()
+		_, err = file.WriteString(MakeTokenString("Bearer", 10*time.Minute) + "\n")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(tokenFile)).To(Succeed())
+	})
+
+	It("Reads the token from the file", func() {
+		// Prepare the server:
+		token, err := os.ReadFile(tokenFile)
+		Expect(err).ToNot(HaveOccurred())
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				VerifyHeaderKV("Authorization", "Bearer "+strings.TrimSpace(string(token))),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command with a runner that uses the token file:
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			TokenFile(tokenFile).
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Fails if the token is also set", func() {
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			TokenFile(tokenFile).
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("conflicting token settings")
+	})
+
+	It("Fails if the file doesn't exist", func() {
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			TokenFile(tokenFile + ".missing").
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("can't read the token file")
+	})
+})
+
+var _ = Describe("Provider proxy", func() {
+	var (
+		proxy    *httptest.Server
+		tunneled chan string
+	)
+
+	BeforeEach(func() {
+		// Create a proxy that requires authentication and tunnels the connections to the API
+		// server, recording the target of each tunnel:
+		tunneled = make(chan string, 10)
+		proxy = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			if r.Method != http.MethodConnect {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			user, password, ok := parseProxyAuthorization(r.Header.Get("Proxy-Authorization"))
+			if !ok || user != "my-user" || password != "my-password" {
+				w.WriteHeader(http.StatusProxyAuthRequired)
+				return
+			}
+			backend, err := net.Dial("tcp", r.Host)
+			Expect(err).ToNot(HaveOccurred())
+			w.WriteHeader(http.StatusOK)
+			client, _, err := http.NewResponseController(w).Hijack()
+			Expect(err).ToNot(HaveOccurred())
+			tunneled <- r.Host
+			go func() {
+				defer backend.Close()
+				_, _ = io.Copy(backend, client)
+			}()
+			go func() {
+				defer client.Close()
+				_, _ = io.Copy(client, backend)
+			}()
+		}))
+	})
+
+	AfterEach(func() {
+		proxy.Close()
+	})
+
+	It("Sends the requests through the proxy", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/cloud_providers"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command with a runner that uses the proxy:
+		proxyURL := strings.Replace(proxy.URL, "http://", "http://my-user:my-password@", 1)
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			ProxyURL(proxyURL).
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check that the request went through the proxy:
+		Expect(tunneled).To(Receive(Equal(strings.TrimPrefix(TestServer.URL(), "https://"))))
+	})
+
+	It("Fails if the proxy rejects the credentials", func() {
+		proxyURL := strings.Replace(proxy.URL, "http://", "http://my-user:wrong@", 1)
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			Retries(0).
+			ProxyURL(proxyURL).
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Proxy Authentication Required")
+		Expect(tunneled).ToNot(Receive())
+	})
+
+	It("Fails if the proxy URL isn't valid", func() {
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			ProxyURL("ftp://proxy.example.com").
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("invalid proxy URL")
+	})
+})
+
+// parseProxyAuthorization extracts the user name and password from the value of a
+// `Proxy-Authorization` header that uses the basic authentication scheme.
+func parseProxyAuthorization(value string) (user, password string, ok bool) {
+	request := &http.Request{
+		Header: http.Header{
+			"Authorization": []string{value},
+		},
+	}
+	return request.BasicAuth()
+}
+
+var _ = Describe("Provider audit log", func() {
+	It("Writes a line for each object created", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "state": "ready"
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(
+					http.MethodPost,
+					"/api/clusters_mgmt/v1/clusters/123/groups/dedicated-admins/users",
+				),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "my-admin"
+				}`),
+			),
+		)
+
+		// Run the apply command with a runner that writes the audit log:
+		logDir, err := os.MkdirTemp("", "rhcs-audit-*.d")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(logDir)
+		logFile := filepath.Join(logDir, "audit.jsonl")
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			AuditLogFile(logFile).
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  resource "rhcs_group_membership" "my_membership" {
+		    cluster = "123"
+		    group   = "dedicated-admins"
+		    user    = "my-admin"
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check that the creation has been written to the audit log:
+		data, err := os.ReadFile(logFile)
+		Expect(err).ToNot(HaveOccurred())
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		Expect(lines).To(HaveLen(1))
+		var event map[string]string
+		err = json.Unmarshal([]byte(lines[0]), &event)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event).To(HaveKeyWithValue("resource", "users"))
+		Expect(event).To(HaveKeyWithValue("action", "create"))
+		Expect(event).To(HaveKeyWithValue("cluster_id", "123"))
+		_, err = time.Parse(time.RFC3339, event["timestamp"])
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Fails if the audit log file can't be opened", func() {
+		runner := NewTerraformRunner().
+			URL(TestServer.URL()).
+			CA(serverCA).
+			Token(MakeTokenString("Bearer", 10*time.Minute)).
+			AuditLogFile("/does/not/exist/audit.jsonl").
+			Build()
+		defer runner.Close()
+		runner.Source(`
+		  data "rhcs_cloud_providers" "all" {
+		  }
+		`)
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("can't open the audit log file")
+	})
+})
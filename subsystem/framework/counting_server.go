@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+)
+
+// CountingServer wraps a test server to count the requests that it receives by method and path,
+// so that tests can check that the provider doesn't send more requests than needed. The counts
+// include the requests that didn't match any handler.
+type CountingServer struct {
+	*Server
+}
+
+// NewCountingServer creates a counting wrapper for the given test server.
+func NewCountingServer(server *Server) *CountingServer {
+	return &CountingServer{
+		Server: server,
+	}
+}
+
+// Count returns the number of requests received with the given method and path. The query of
+// the requests is ignored.
+func (s *CountingServer) Count(method, path string) int {
+	return s.Counts()[requestKey(method, path)]
+}
+
+// Counts returns the number of requests received for each method and path, for example
+// `GET /api/clusters_mgmt/v1/cloud_providers`.
+func (s *CountingServer) Counts() map[string]int {
+	result := map[string]int{}
+	for _, request := range s.ReceivedRequests() {
+		result[requestKey(request.Method, request.URL.Path)]++
+	}
+	return result
+}
+
+// AssertCount checks that the server received exactly the given number of requests with the
+// given method and path.
+func (s *CountingServer) AssertCount(method, path string, expected int) {
+	actual := s.Count(method, path)
+	ExpectWithOffset(1, actual).To(
+		Equal(expected),
+		"Expected %d '%s' requests, but received %d, all the requests were:\n%s",
+		expected, requestKey(method, path), actual, s.describeCounts(),
+	)
+}
+
+// describeCounts returns a sorted list of the counts, one per line, to report failures.
+func (s *CountingServer) describeCounts() string {
+	counts := s.Counts()
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	lines := make([]string, len(keys))
+	for i, key := range keys {
+		lines[i] = fmt.Sprintf("%s: %d", key, counts[key])
+	}
+	return strings.Join(lines, "\n")
+}
+
+func requestKey(method, path string) string {
+	return method + " " + path
+}
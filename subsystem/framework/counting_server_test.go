@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	. "github.com/onsi/gomega/ghttp"       // nolint
+)
+
+var _ = Describe("Counting server", func() {
+	var server *CountingServer
+
+	BeforeEach(func() {
+		server = NewCountingServer(NewServer())
+		server.AllowUnhandledRequests = true
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	send := func(method, path string) {
+		request, err := http.NewRequest(method, server.URL()+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+	}
+
+	It("Counts the requests by method and path", func() {
+		send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123?search=x")
+		send(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123")
+
+		Expect(server.Count(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")).To(Equal(2))
+		Expect(server.Count(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123")).To(Equal(1))
+		Expect(server.Count(http.MethodPost, "/api/clusters_mgmt/v1/clusters")).To(BeZero())
+		Expect(server.Counts()).To(Equal(map[string]int{
+			"GET /api/clusters_mgmt/v1/clusters/123":    2,
+			"DELETE /api/clusters_mgmt/v1/clusters/123": 1,
+		}))
+		server.AssertCount(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123", 2)
+	})
+
+	It("Reports all the counts when the assertion fails", func() {
+		send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+		send(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123")
+
+		failures := InterceptGomegaFailures(func() {
+			server.AssertCount(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123", 1)
+		})
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0]).To(ContainSubstring("Expected 1 'GET /api/clusters_mgmt/v1/clusters/123' requests"))
+		Expect(failures[0]).To(ContainSubstring("GET /api/clusters_mgmt/v1/clusters/123: 2"))
+	})
+})
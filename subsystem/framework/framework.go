@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
 	. "github.com/onsi/gomega"                         // nolint
@@ -42,26 +43,60 @@ var (
 
 // TerraformRunnerBuilder contains the data and logic needed to build a terraform runner.
 type TerraformRunnerBuilder struct {
-	url   string
-	ca    string
-	token string
+	url          string
+	ca           string
+	token        string
+	tokenFile    string
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	timeout      int
+	retries      *int
+	agentPrefix  string
+	proxyURL     string
+	auditLog     string
+	version      string
+	pluginCache  string
+	devOverride  string
 }
 
+// now returns the current time. It is a variable so that tests can replace it with a fake clock.
+var now = time.Now
+
 type RunOutput struct {
 	out      string
 	err      string
+	duration time.Duration
 	ExitCode int
 }
 
+// Duration returns the time that it took to run the command.
+func (ro *RunOutput) Duration() time.Duration {
+	return ro.duration
+}
+
+// AssertUnder checks that running the command took less than the given duration.
+func (ro *RunOutput) AssertUnder(d time.Duration) {
+	ExpectWithOffset(1, ro.duration).To(
+		BeNumerically("<", d),
+		"Expected the command to complete in less than %s, but it took %s", d, ro.duration,
+	)
+}
+
 func (ro *RunOutput) VerifyErrorContainsSubstring(sub string) {
 	Expect(ro.err).To(ContainSubstring(sub))
 }
 
+func (ro *RunOutput) VerifyOutputContainsSubstring(sub string) {
+	Expect(ro.out).To(ContainSubstring(sub))
+}
+
 // TerraformRunner contains the data and logic needed to run Terraform.
 type TerraformRunner struct {
-	binary string
-	dir    string
-	env    []string
+	binary  string
+	dir     string
+	env     []string
+	targets []string
 }
 
 // NewTerraformRunner creates a new Terraform runner.
@@ -87,12 +122,87 @@ func (b *TerraformRunnerBuilder) Token(value string) *TerraformRunnerBuilder {
 	return b
 }
 
+// TokenFile sets the path of the file that contains the authentication token.
+func (b *TerraformRunnerBuilder) TokenFile(value string) *TerraformRunnerBuilder {
+	b.tokenFile = value
+	return b
+}
+
+// TokenURL sets the URL of the OpenID server used to request access tokens.
+func (b *TerraformRunnerBuilder) TokenURL(value string) *TerraformRunnerBuilder {
+	b.tokenURL = value
+	return b
+}
+
+// ClientCredentials sets the client identifier and secret of the service account used to
+// connect to the OCM API server, instead of a token.
+func (b *TerraformRunnerBuilder) ClientCredentials(id, secret string) *TerraformRunnerBuilder {
+	b.clientID = id
+	b.clientSecret = secret
+	return b
+}
+
+// Timeout sets the timeout, in seconds, of the requests sent by the provider to the OCM API server.
+func (b *TerraformRunnerBuilder) Timeout(seconds int) *TerraformRunnerBuilder {
+	b.timeout = seconds
+	return b
+}
+
+// Retries sets the number of times that the provider retries the failed requests.
+func (b *TerraformRunnerBuilder) Retries(value int) *TerraformRunnerBuilder {
+	b.retries = &value
+	return b
+}
+
+// UserAgentPrefix sets the text that the provider adds at the beginning of the user agent.
+func (b *TerraformRunnerBuilder) UserAgentPrefix(value string) *TerraformRunnerBuilder {
+	b.agentPrefix = value
+	return b
+}
+
+// ProxyURL sets the URL of the proxy that the provider uses to connect to the OCM API server.
+func (b *TerraformRunnerBuilder) ProxyURL(value string) *TerraformRunnerBuilder {
+	b.proxyURL = value
+	return b
+}
+
+// AuditLogFile sets the file where the provider writes the audit log of the changes it makes.
+func (b *TerraformRunnerBuilder) AuditLogFile(path string) *TerraformRunnerBuilder {
+	b.auditLog = path
+	return b
+}
+
+// ProviderVersion sets the version constraint of the provider in the `required_providers` block,
+// for example `= 1.6.2`, so that the `init` command installs a specific version of the provider.
+// The default is `>= 0.0.1`, which accepts any version.
+func (b *TerraformRunnerBuilder) ProviderVersion(value string) *TerraformRunnerBuilder {
+	b.version = value
+	return b
+}
+
+// PluginCacheDir sets the directory where Terraform caches the providers that it installs, so
+// that runners using the same directory install them only once.
+func (b *TerraformRunnerBuilder) PluginCacheDir(dir string) *TerraformRunnerBuilder {
+	b.pluginCache = dir
+	return b
+}
+
+// DevOverride sets the directory that contains a locally built provider binary. When it is set
+// Terraform uses that binary directly, via the `dev_overrides` of its CLI configuration, and the
+// runner doesn't run the `init` command, so no provider is installed.
+func (b *TerraformRunnerBuilder) DevOverride(dir string) *TerraformRunnerBuilder {
+	b.devOverride = dir
+	return b
+}
+
 // Build uses the information stored in the builder to create a new Terraform runner.
 func (b *TerraformRunnerBuilder) Build() *TerraformRunner {
 	// Check parameters:
 	ExpectWithOffset(1, b.url).ToNot(BeEmpty())
 	ExpectWithOffset(1, b.ca).ToNot(BeEmpty())
-	ExpectWithOffset(1, b.token).ToNot(BeEmpty())
+	ExpectWithOffset(1, b.token == "" && b.tokenFile == "" && b.clientID == "").To(
+		BeFalse(), "Either the token, the token file or the client credentials must be set",
+	)
 
 	// Check that the Terraform tfBinary is available in the path:
 	tfBinary, err := exec.LookPath("terraform")
@@ -107,29 +217,143 @@ func (b *TerraformRunnerBuilder) Build() *TerraformRunner {
 
 	// Create the main file:
 	mainPath := filepath.Join(tmpDir, "main.tf")
-	mainContent := EvaluateTemplate(`
+	mainContent := b.mainConfig()
+	err = ioutil.WriteFile(mainPath, []byte(mainContent), 0600)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+	// Create the plugin cache directory, as Terraform doesn't create it:
+	if b.pluginCache != "" {
+		err = os.MkdirAll(b.pluginCache, 0700)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	}
+
+	// Use the locally built provider if requested, otherwise install it with the init command:
+	envList := b.environment()
+	if b.devOverride != "" {
+		configPath, err := WriteDevOverrideConfig(tmpDir, b.devOverride)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		envList = append(envList, "TF_CLI_CONFIG_FILE="+configPath)
+		return &TerraformRunner{
+			binary: tfBinary,
+			dir:    tmpDir,
+			env:    envList,
+		}
+	}
+	initCmd := exec.Command(tfBinary, "init")
+	initCmd.Env = envList
+	initCmd.Dir = tmpDir
+	initCmd.Stdout = GinkgoWriter
+	initCmd.Stderr = GinkgoWriter
+	err = initCmd.Run()
+	if err != nil {
+		message := fmt.Sprintf(
+			"Terraform init finished with exit code %d",
+			initCmd.ProcessState.ExitCode(),
+		)
+		Fail(message, 1)
+	}
+
+	// Create and populate the object:
+	return &TerraformRunner{
+		binary: tfBinary,
+		dir:    tmpDir,
+		env:    envList,
+	}
+}
+
+// mainConfig returns the content of the main file of the runner, with the provider requirements
+// and configuration.
+func (b *TerraformRunnerBuilder) mainConfig() string {
+	retries := ""
+	if b.retries != nil {
+		retries = fmt.Sprintf("%d", *b.retries)
+	}
+	version := ">= 0.0.1"
+	if b.version != "" {
+		version = b.version
+	}
+	return EvaluateTemplate(`
 		terraform {
 		  required_providers {
 		    rhcs = {
                 source = "terraform.local/local/rhcs"
-                version = ">= 0.0.1"
+                version = "{{ .Version }}"
 		    }
 		  }
 		}
 
 		provider "rhcs" {
-		  url         = "{{ .URL }}"
-		  token       = "{{ .Token }}"
-		  trusted_cas = file("{{ .CA }}")
+		  url           = "{{ .URL }}"
+		  {{ if .Token }}
+		  token         = "{{ .Token }}"
+		  {{ end }}
+		  {{ if .TokenFile }}
+		  token_file    = "{{ .TokenFile }}"
+		  {{ end }}
+		  {{ if .TokenURL }}
+		  token_url     = "{{ .TokenURL }}"
+		  {{ end }}
+		  {{ if .ClientID }}
+		  client_id     = "{{ .ClientID }}"
+		  client_secret = "{{ .ClientSecret }}"
+		  {{ end }}
+		  {{ if .Timeout }}
+		  timeout       = {{ .Timeout }}
+		  {{ end }}
+		  {{ if .Retries }}
+		  retries       = {{ .Retries }}
+		  {{ end }}
+		  {{ if .AgentPrefix }}
+		  user_agent_prefix = "{{ .AgentPrefix }}"
+		  {{ end }}
+		  {{ if .ProxyURL }}
+		  proxy_url     = "{{ .ProxyURL }}"
+		  {{ end }}
+		  {{ if .AuditLog }}
+		  audit_log_file = "{{ .AuditLog }}"
+		  {{ end }}
+		  trusted_cas   = file("{{ .CA }}")
 		}
 		`,
+		"Version", version,
 		"URL", b.url,
 		"Token", b.token,
+		"TokenFile", strings.ReplaceAll(b.tokenFile, "\\", "/"),
+		"TokenURL", b.tokenURL,
+		"ClientID", b.clientID,
+		"ClientSecret", b.clientSecret,
+		"Timeout", b.timeout,
+		"Retries", retries,
+		"AgentPrefix", b.agentPrefix,
+		"ProxyURL", b.proxyURL,
+		"AuditLog", strings.ReplaceAll(b.auditLog, "\\", "/"),
 		"CA", strings.ReplaceAll(b.ca, "\\", "/"),
 	)
-	err = ioutil.WriteFile(mainPath, []byte(mainContent), 0600)
-	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+}
+
+// WriteDevOverrideConfig writes to the given directory a Terraform CLI configuration file that
+// makes Terraform use the provider binary of the given directory instead of installing it. It
+// returns the path of the file.
+func WriteDevOverrideConfig(dir, providerDir string) (string, error) {
+	path := filepath.Join(dir, ".terraformrc")
+	content := EvaluateTemplate(`
+		provider_installation {
+		  dev_overrides {
+		    "terraform.local/local/rhcs" = "{{ .ProviderDir }}"
+		  }
+		}
+		`,
+		"ProviderDir", strings.ReplaceAll(providerDir, "\\", "/"),
+	)
+	err := ioutil.WriteFile(path, []byte(content), 0600)
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
 
+// environment returns the environment variables used to run Terraform.
+func (b *TerraformRunnerBuilder) environment() []string {
 	// Parse the current environment into a map so that it is easy to update it:
 	envMap := map[string]string{}
 	for _, text := range os.Environ() {
@@ -152,33 +376,20 @@ func (b *TerraformRunnerBuilder) Build() *TerraformRunner {
 	// Enable verbose debug:
 	envMap["TF_LOG"] = "DEBUG"
 
+	// Share the installed providers. The working directory of each runner starts without a
+	// dependency lock file, and Terraform only uses the cache for the providers that are
+	// already in the lock file unless told otherwise:
+	if b.pluginCache != "" {
+		envMap["TF_PLUGIN_CACHE_DIR"] = b.pluginCache
+		envMap["TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE"] = "true"
+	}
+
 	// Reconstruct the environment list:
 	envList := make([]string, 0, len(envMap))
 	for name, value := range envMap {
 		envList = append(envList, name+"="+value)
 	}
-
-	// Run the init command:
-	initCmd := exec.Command(tfBinary, "init")
-	initCmd.Env = envList
-	initCmd.Dir = tmpDir
-	initCmd.Stdout = GinkgoWriter
-	initCmd.Stderr = GinkgoWriter
-	err = initCmd.Run()
-	if err != nil {
-		message := fmt.Sprintf(
-			"Terraform init finished with exit code %d",
-			initCmd.ProcessState.ExitCode(),
-		)
-		Fail(message, 1)
-	}
-
-	// Create and populate the object:
-	return &TerraformRunner{
-		binary: tfBinary,
-		dir:    tmpDir,
-		env:    envList,
-	}
+	return envList
 }
 
 // Source sets the Terraform source of the test.
@@ -199,7 +410,9 @@ func (r *TerraformRunner) Run(args ...string) RunOutput {
 	cmd.Dir = r.dir
 	cmd.Stdout = &outb
 	cmd.Stderr = &errb
+	start := now()
 	err = cmd.Run()
+	duration := now().Sub(start)
 	switch err.(type) {
 	case *exec.ExitError:
 		// Nothing, this is a normal situation and the caller is expected to check the
@@ -214,18 +427,109 @@ func (r *TerraformRunner) Run(args ...string) RunOutput {
 	return RunOutput{
 		out:      outb.String(),
 		err:      errb.String(),
+		duration: duration,
 		ExitCode: cmd.ProcessState.ExitCode(),
 	}
 }
 
+// Target restricts the following `apply` and `plan` commands to the resource with the given
+// address. It can be called multiple times to add more addresses. When no target is set all
+// the resources are applied.
+func (r *TerraformRunner) Target(addr string) *TerraformRunner {
+	r.targets = append(r.targets, addr)
+	return r
+}
+
+// ClearTargets removes the targets added with the Target method.
+func (r *TerraformRunner) ClearTargets() *TerraformRunner {
+	r.targets = nil
+	return r
+}
+
+// targetArgs returns the `-target` flags corresponding to the targets of the runner.
+func (r *TerraformRunner) targetArgs() []string {
+	args := make([]string, len(r.targets))
+	for i, target := range r.targets {
+		args[i] = "-target=" + target
+	}
+	return args
+}
+
 // Validate runs the `validate` command.
 func (r *TerraformRunner) Validate() RunOutput {
 	return r.Run("validate")
 }
 
+// Plan runs the `plan` command.
+func (r *TerraformRunner) Plan() RunOutput {
+	return r.Run(append([]string{"plan", "-no-color"}, r.targetArgs()...)...)
+}
+
+// AssertIdempotent checks that applying the configuration again wouldn't change anything. It
+// runs the `plan` command and fails if the plan contains changes.
+func (r *TerraformRunner) AssertIdempotent() {
+	args := append([]string{"plan", "-no-color", "-detailed-exitcode"}, r.targetArgs()...)
+	runOutput := r.Run(args...)
+	ExpectWithOffset(1, runOutput.ExitCode).ToNot(
+		Equal(1),
+		"Expected the plan to succeed, but it failed:\n%s", runOutput.err,
+	)
+	ExpectWithOffset(1, runOutput.ExitCode).To(
+		BeZero(),
+		"Expected the plan to contain no changes, but it contains:\n%s", runOutput.out,
+	)
+}
+
+// AssertReplace checks that applying the configuration would replace the resource with the given
+// address, for example `rhcs_cluster.my_cluster`, instead of updating it in place. It runs the
+// `plan` command and fails unless the planned actions for the resource are a delete and a create,
+// in any order, so that resources with `create_before_destroy` are accepted as well.
+func (r *TerraformRunner) AssertReplace(addr string) {
+	planFile := "replace.tfplan"
+	defer os.Remove(filepath.Join(r.dir, planFile))
+	args := append([]string{"plan", "-no-color", "-out=" + planFile}, r.targetArgs()...)
+	runOutput := r.Run(args...)
+	ExpectWithOffset(1, runOutput.ExitCode).To(
+		BeZero(),
+		"Expected the plan to succeed, but it failed:\n%s", runOutput.err,
+	)
+	runOutput = r.Run("show", "-no-color", "-json", planFile)
+	ExpectWithOffset(1, runOutput.ExitCode).To(
+		BeZero(),
+		"Expected the plan to be shown, but it failed:\n%s", runOutput.err,
+	)
+	var plan struct {
+		ResourceChanges []struct {
+			Address string `json:"address"`
+			Change  struct {
+				Actions []string `json:"actions"`
+			} `json:"change"`
+		} `json:"resource_changes"`
+	}
+	err := json.Unmarshal([]byte(runOutput.out), &plan)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	var actions []string
+	for _, change := range plan.ResourceChanges {
+		if change.Address == addr {
+			actions = change.Change.Actions
+			break
+		}
+	}
+	if !ExpectWithOffset(1, actions).ToNot(
+		BeEmpty(),
+		"The plan doesn't contain resource '%s'", addr,
+	) {
+		return
+	}
+	ExpectWithOffset(1, actions).To(
+		Or(Equal([]string{"delete", "create"}), Equal([]string{"create", "delete"})),
+		"Expected resource '%s' to be replaced", addr,
+	)
+}
+
 // Apply runs the `apply` command.
 func (r *TerraformRunner) Apply() RunOutput {
-	return r.Run("apply", "-auto-approve")
+	return r.Run(append([]string{"apply", "-auto-approve"}, r.targetArgs()...)...)
 }
 
 // Destroy runs the `destroy` command.
@@ -258,6 +562,45 @@ func (r *TerraformRunner) State() interface{} {
 	return result
 }
 
+// StateMeta returns the serial and the lineage of the Terraform state, and the version of
+// Terraform that wrote it. Terraform increments the serial every time that it changes the state.
+func (r *TerraformRunner) StateMeta() (serial int64, lineage string, tfVersion string) {
+	data, err := ioutil.ReadFile(filepath.Join(r.dir, "terraform.tfstate"))
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	var meta struct {
+		Serial           int64  `json:"serial"`
+		Lineage          string `json:"lineage"`
+		TerraformVersion string `json:"terraform_version"`
+	}
+	err = json.Unmarshal(data, &meta)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return meta.Serial, meta.Lineage, meta.TerraformVersion
+}
+
+// SnapshotState returns a copy of the current Terraform state, so that it can be restored later
+// with RestoreState. The result is nil if there is no state yet.
+func (r *TerraformRunner) SnapshotState() ([]byte, error) {
+	data, err := ioutil.ReadFile(filepath.Join(r.dir, "terraform.tfstate"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// RestoreState replaces the Terraform state with a snapshot previously returned by SnapshotState.
+// Restoring a nil snapshot removes the state.
+func (r *TerraformRunner) RestoreState(snapshot []byte) error {
+	path := filepath.Join(r.dir, "terraform.tfstate")
+	if snapshot == nil {
+		err := os.Remove(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return ioutil.WriteFile(path, snapshot, 0600)
+}
+
 // Resource returns the resource stored in the state with the given type and identifier.
 func (r *TerraformRunner) Resource(typ, name string) interface{} {
 	state := r.State()
@@ -275,6 +618,18 @@ func (r *TerraformRunner) Resource(typ, name string) interface{} {
 	return results[0]
 }
 
+// ResourceCount returns the number of instances of resources of the given type stored in the state.
+func (r *TerraformRunner) ResourceCount(typ string) int {
+	state := r.State()
+	if state == nil {
+		return 0
+	}
+	filter := fmt.Sprintf(`.resources[] | select(.type == "%s") | .instances[]`, typ)
+	results, err := JQ(filter, state)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return len(results)
+}
+
 // Close releases all the resources used by the Terraform runner and removes all
 // temporary files and directories.
 func (r *TerraformRunner) Close() {
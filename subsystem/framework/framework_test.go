@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+var _ = Describe("Terraform runner", func() {
+	var runner *TerraformRunner
+
+	BeforeEach(func() {
+		// The `true` command stands in for the Terraform binary, so the apply is trivial and
+		// always succeeds:
+		dir, err := os.MkdirTemp("", "terraform-runner-")
+		Expect(err).ToNot(HaveOccurred())
+		runner = &TerraformRunner{
+			binary: "true",
+			dir:    dir,
+		}
+	})
+
+	AfterEach(func() {
+		now = time.Now
+		runner.Close()
+	})
+
+	It("Measures the duration of the command", func() {
+		start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		times := []time.Time{start, start.Add(3 * time.Second)}
+		now = func() time.Time {
+			result := times[0]
+			times = times[1:]
+			return result
+		}
+
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+		Expect(runOutput.Duration()).To(Equal(3 * time.Second))
+		runOutput.AssertUnder(4 * time.Second)
+	})
+
+	It("Completes a trivial apply under a generous bound", func() {
+		runOutput := runner.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+		runOutput.AssertUnder(time.Minute)
+	})
+
+	It("Accepts a plan without changes as idempotent", func() {
+		runner.AssertIdempotent()
+	})
+
+	It("Rejects a plan with changes as not idempotent", func() {
+		// With `-detailed-exitcode` Terraform exits with code 2 when the plan contains changes:
+		script := filepath.Join(runner.dir, "terraform.sh")
+		err := os.WriteFile(script, []byte("#!/bin/sh\necho '1 to change'\nexit 2\n"), 0700)
+		Expect(err).ToNot(HaveOccurred())
+		runner.binary = script
+
+		failures := InterceptGomegaFailures(func() {
+			runner.AssertIdempotent()
+		})
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0]).To(ContainSubstring("1 to change"))
+	})
+
+	It("Accepts a plan that replaces the resource", func() {
+		writeShowScript(runner, `["delete", "create"]`)
+		runner.AssertReplace("rhcs_cluster.my_cluster")
+	})
+
+	It("Accepts a plan that creates the resource before destroying it", func() {
+		writeShowScript(runner, `["create", "delete"]`)
+		runner.AssertReplace("rhcs_cluster.my_cluster")
+	})
+
+	It("Rejects a plan that updates the resource in place", func() {
+		writeShowScript(runner, `["update"]`)
+		failures := InterceptGomegaFailures(func() {
+			runner.AssertReplace("rhcs_cluster.my_cluster")
+		})
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0]).To(ContainSubstring("Expected resource 'rhcs_cluster.my_cluster' to be replaced"))
+	})
+
+	It("Rejects a plan that doesn't contain the resource", func() {
+		writeShowScript(runner, `["delete", "create"]`)
+		failures := InterceptGomegaFailures(func() {
+			runner.AssertReplace("rhcs_cluster.other_cluster")
+		})
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0]).To(ContainSubstring("doesn't contain resource 'rhcs_cluster.other_cluster'"))
+	})
+
+	It("Restores a snapshot of the state", func() {
+		statePath := filepath.Join(runner.dir, "terraform.tfstate")
+		err := os.WriteFile(statePath, []byte(`{"serial": 1}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		snapshot, err := runner.SnapshotState()
+		Expect(err).ToNot(HaveOccurred())
+
+		err = os.WriteFile(statePath, []byte(`{"serial": 2}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		err = runner.RestoreState(snapshot)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := os.ReadFile(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`{"serial": 1}`))
+	})
+
+	It("Removes the state when restoring a snapshot taken before it existed", func() {
+		snapshot, err := runner.SnapshotState()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshot).To(BeNil())
+
+		statePath := filepath.Join(runner.dir, "terraform.tfstate")
+		err = os.WriteFile(statePath, []byte(`{"serial": 1}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		err = runner.RestoreState(snapshot)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statePath).ToNot(BeAnExistingFile())
+	})
+})
+
+// writeShowScript replaces the Terraform binary of the runner with a script that accepts any plan
+// and shows a plan where the actions of resource `rhcs_cluster.my_cluster` are the given ones.
+func writeShowScript(runner *TerraformRunner, actions string) {
+	script := filepath.Join(runner.dir, "terraform.sh")
+	content := fmt.Sprintf(`#!/bin/sh
+if [ "$1" = "show" ]; then
+  echo '{"resource_changes": [{"address": "rhcs_cluster.my_cluster", "change": {"actions": %s}}]}'
+fi
+`, actions)
+	err := os.WriteFile(script, []byte(content), 0700)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	runner.binary = script
+}
+
+var _ = Describe("Terraform runner builder", func() {
+	It("Sets the plugin cache directory in the environment", func() {
+		env := NewTerraformRunner().PluginCacheDir("/my/cache").environment()
+		Expect(env).To(ContainElement("TF_PLUGIN_CACHE_DIR=/my/cache"))
+		Expect(env).To(ContainElement("TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE=true"))
+	})
+
+	It("Accepts any provider version by default", func() {
+		config := NewTerraformRunner().mainConfig()
+		Expect(config).To(ContainSubstring(`version = ">= 0.0.1"`))
+	})
+
+	It("Pins the provider version", func() {
+		config := NewTerraformRunner().ProviderVersion("= 1.6.2").mainConfig()
+		Expect(config).To(ContainSubstring(`version = "= 1.6.2"`))
+		Expect(config).ToNot(ContainSubstring(">= 0.0.1"))
+	})
+
+	It("Doesn't change the plugin cache of the environment by default", func() {
+		os.Setenv("TF_PLUGIN_CACHE_DIR", "/user/cache")
+		defer os.Unsetenv("TF_PLUGIN_CACHE_DIR")
+		env := NewTerraformRunner().environment()
+		Expect(env).To(ContainElement("TF_PLUGIN_CACHE_DIR=/user/cache"))
+		Expect(env).ToNot(ContainElement(HavePrefix("TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE=")))
+	})
+})
+
+var _ = Describe("Dev override configuration", func() {
+	It("Points the provider to the given directory", func() {
+		dir, err := os.MkdirTemp("", "terraform-config-")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		path, err := WriteDevOverrideConfig(dir, "/my/provider")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal(filepath.Join(dir, ".terraformrc")))
+		data, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("dev_overrides"))
+		Expect(string(data)).To(ContainSubstring(`"terraform.local/local/rhcs" = "/my/provider"`))
+		Expect(string(data)).ToNot(ContainSubstring("direct"))
+	})
+})
@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/onsi/gomega/types"
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+// ContainJQ creates a matcher that checks that the list obtained by applying a `jq` filter to the
+// actual value contains the given element. The filter can either return a single list, for
+// example `.attributes.items`, or one result per element, for example `.attributes.items[].id`.
+// The order of the elements isn't relevant.
+func ContainJQ(filter string, element interface{}) types.GomegaMatcher {
+	return &containJQMatcher{
+		filter:  filter,
+		element: element,
+	}
+}
+
+type containJQMatcher struct {
+	filter  string
+	element interface{}
+	items   []interface{}
+}
+
+func (m *containJQMatcher) Match(actual interface{}) (success bool, err error) {
+	// Run the query:
+	results, err := JQ(m.filter, actual)
+	if err != nil {
+		return
+	}
+
+	// A single list result is flattened so that both forms of filters are supported:
+	m.items = results
+	if len(results) == 1 {
+		if list, ok := results[0].([]interface{}); ok {
+			m.items = list
+		}
+	}
+
+	for _, item := range m.items {
+		if reflect.DeepEqual(item, m.element) {
+			success = true
+			break
+		}
+	}
+	return
+}
+
+func (m *containJQMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf(
+		"Expected results of running JQ filter\n\t%s\n"+
+			"on input\n\t%s\n"+
+			"to contain\n\t%s\n"+
+			"but the results are\n\t%s\n",
+		m.filter, prettyJQ(actual), prettyJQ(m.element), prettyJQ(m.items),
+	)
+}
+
+func (m *containJQMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf(
+		"Expected results of running JQ filter\n\t%s\n"+
+			"on input\n\t%s\n"+
+			"to not contain\n\t%s\n",
+		m.filter, prettyJQ(actual), prettyJQ(m.element),
+	)
+}
+
+// BeJQNumberInRange creates a matcher that checks that the result of applying a `jq` filter to the
+// actual value is a number between the given minimum and maximum, both included. The filter must
+// return exactly one result.
+func BeJQNumberInRange(filter string, min, max float64) types.GomegaMatcher {
+	return &jqNumberInRangeMatcher{
+		filter: filter,
+		min:    min,
+		max:    max,
+	}
+}
+
+type jqNumberInRangeMatcher struct {
+	filter string
+	min    float64
+	max    float64
+	result interface{}
+}
+
+func (m *jqNumberInRangeMatcher) Match(actual interface{}) (success bool, err error) {
+	// Run the query:
+	results, err := JQ(m.filter, actual)
+	if err != nil {
+		return
+	}
+	if len(results) != 1 {
+		err = fmt.Errorf(
+			"expected JQ filter '%s' to return exactly one result, but it returned %d",
+			m.filter, len(results),
+		)
+		return
+	}
+	m.result = results[0]
+
+	// Compare the number:
+	value := reflect.ValueOf(m.result)
+	var number float64
+	switch {
+	case value.CanFloat():
+		number = value.Float()
+	case value.CanInt():
+		number = float64(value.Int())
+	case value.CanUint():
+		number = float64(value.Uint())
+	default:
+		err = fmt.Errorf(
+			"expected JQ filter '%s' to return a number, but it returned %s",
+			m.filter, prettyJQ(m.result),
+		)
+		return
+	}
+	success = number >= m.min && number <= m.max
+	return
+}
+
+func (m *jqNumberInRangeMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf(
+		"Expected result of running JQ filter\n\t%s\n"+
+			"on input\n\t%s\n"+
+			"to be a number between %v and %v\n"+
+			"but the result is\n\t%s\n",
+		m.filter, prettyJQ(actual), m.min, m.max, prettyJQ(m.result),
+	)
+}
+
+func (m *jqNumberInRangeMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf(
+		"Expected result of running JQ filter\n\t%s\n"+
+			"on input\n\t%s\n"+
+			"to not be a number between %v and %v\n"+
+			"but the result is\n\t%s\n",
+		m.filter, prettyJQ(actual), m.min, m.max, prettyJQ(m.result),
+	)
+}
+
+func prettyJQ(object interface{}) string {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetIndent("\t", "  ")
+	err := encoder.Encode(object)
+	if err != nil {
+		return fmt.Sprintf("\t%v", object)
+	}
+	return strings.TrimRight(buffer.String(), "\n")
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+var _ = Describe("JQ matchers", func() {
+	var resource interface{}
+
+	BeforeEach(func() {
+		err := json.Unmarshal([]byte(`{
+		  "attributes": {
+		    "replicas": 4,
+		    "items": [
+		      {
+		        "id": "aws"
+		      },
+		      {
+		        "id": "gcp"
+		      }
+		    ]
+		  }
+		}`), &resource)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("ContainJQ", func() {
+		It("Matches an element present in the list", func() {
+			Expect(resource).To(ContainJQ(`.attributes.items[].id`, "gcp"))
+			Expect(resource).To(ContainJQ(`.attributes.items[].id`, "aws"))
+			Expect(resource).To(ContainJQ(`[.attributes.items[].id]`, "gcp"))
+		})
+
+		It("Doesn't match an element absent from the list", func() {
+			Expect(resource).ToNot(ContainJQ(`.attributes.items[].id`, "azure"))
+			Expect(resource).ToNot(ContainJQ(`[.attributes.items[].id]`, "azure"))
+		})
+
+		It("Doesn't match when the filter returns nothing", func() {
+			Expect(resource).ToNot(ContainJQ(`.attributes.missing[]?`, "gcp"))
+		})
+	})
+
+	Context("BeJQNumberInRange", func() {
+		It("Matches a number inside the range", func() {
+			Expect(resource).To(BeJQNumberInRange(`.attributes.replicas`, 2, 6))
+		})
+
+		It("Matches a number at the limits of the range", func() {
+			Expect(resource).To(BeJQNumberInRange(`.attributes.replicas`, 4, 6))
+			Expect(resource).To(BeJQNumberInRange(`.attributes.replicas`, 2, 4))
+		})
+
+		It("Doesn't match a number below the range", func() {
+			Expect(resource).ToNot(BeJQNumberInRange(`.attributes.replicas`, 5, 6))
+		})
+
+		It("Doesn't match a number above the range", func() {
+			Expect(resource).ToNot(BeJQNumberInRange(`.attributes.replicas`, 1, 3))
+		})
+
+		It("Fails if the result isn't a number", func() {
+			success, err := BeJQNumberInRange(`.attributes.items[0].id`, 1, 3).Match(resource)
+			Expect(err).To(HaveOccurred())
+			Expect(success).To(BeFalse())
+		})
+
+		It("Fails if the filter doesn't return exactly one result", func() {
+			success, err := BeJQNumberInRange(`.attributes.items[].id`, 1, 3).Match(resource)
+			Expect(err).To(HaveOccurred())
+			Expect(success).To(BeFalse())
+		})
+	})
+})
@@ -18,6 +18,7 @@ package hcp
 
 import (
 	"net/http"
+	"os"
 	"testing"
 	"time"
 
@@ -34,6 +35,21 @@ func TestProvider(t *testing.T) {
 	RunSpecs(t, "HCP")
 }
 
+// pluginCacheDir is the directory where the runners cache the providers that they install, so
+// that each test doesn't install them again.
+var pluginCacheDir string
+
+var _ = BeforeSuite(func() {
+	var err error
+	pluginCacheDir, err = os.MkdirTemp("", "rhcs-plugin-cache-*.d")
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	err := os.RemoveAll(pluginCacheDir)
+	Expect(err).ToNot(HaveOccurred())
+})
+
 var _ = BeforeEach(func() {
 	format.MaxLength = 0 // set gomega format MaxLength to 0 to see all the diff when fails
 	// Create the server:
@@ -57,6 +73,8 @@ var _ = BeforeEach(func() {
 		URL(TestServer.URL()).
 		CA(ca).
 		Token(token).
+		PluginCacheDir(pluginCacheDir).
+		DevOverride(os.Getenv("RHCS_DEV_OVERRIDE")).
 		Build()
 })
 
@@ -62,6 +62,12 @@ var _ = Describe("Hcp Machine pool", func() {
 			),
 		)
 	}
+	// The version of a new or upgraded machine pool is checked against the version of the control
+	// plane when the plan is computed, and Terraform computes the plan again when applying it:
+	preparePlanClusterReads := func(clusterId string) {
+		prepareClusterRead(clusterId)
+		prepareClusterRead(clusterId)
+	}
 	Context("static validation", func() {
 		BeforeEach(func() {
 			prepareClusterRead("123")
@@ -182,7 +188,10 @@ var _ = Describe("Hcp Machine pool", func() {
 				replicas = 5
 				subnet_id = "subnet-123"
 			}`)
-			Expect(Terraform.Validate()).NotTo(BeZero())
+			runOutput := Terraform.Validate()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid ec2_metadata_http_tokens")
+			runOutput.VerifyErrorContainsSubstring("Got bad_string")
 		})
 
 		It("is invalid to specify unsupported image type", func() {
@@ -215,6 +224,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with compute nodes", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -289,8 +299,106 @@ var _ = Describe("Hcp Machine pool", func() {
 			Expect(resource).To(MatchJQ(`.attributes.labels | length`, 2))
 		})
 
+		It("Can create machine pool without auto repair", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/node_pools",
+					),
+					VerifyJQ(`has("auto_repair")`, false),
+					RespondWithJSON(http.StatusCreated, `{
+					"id":"my-pool",
+					"aws_node_pool":{
+					   "instance_type":"r5.xlarge",
+					   "instance_profile": "bla"
+					},
+					"auto_repair": true,
+					"replicas":2,
+					"subnet":"id-1",
+					"availability_zone":"us-east-1a",
+					"version": {
+						"raw_id": "4.14.10"
+					}
+				}`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Check the state:
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", "my_pool")
+			Expect(resource).To(MatchJQ(".attributes.auto_repair", true))
+		})
+
+		It("Can create machine pool with auto repair disabled", func() {
+			// Prepare the server:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/node_pools",
+					),
+					VerifyJQ(".auto_repair", false),
+					RespondWithJSON(http.StatusCreated, `{
+					"id":"my-pool",
+					"aws_node_pool":{
+					   "instance_type":"r5.xlarge",
+					   "instance_profile": "bla"
+					},
+					"auto_repair": false,
+					"replicas":2,
+					"subnet":"id-1",
+					"availability_zone":"us-east-1a",
+					"version": {
+						"raw_id": "4.14.10"
+					}
+				}`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				auto_repair = false
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Check the state:
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", "my_pool")
+			Expect(resource).To(MatchJQ(".attributes.auto_repair", false))
+		})
+
 		It("Can create machine pool with version", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -344,6 +452,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with additional security groups", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -457,6 +566,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can't update additional security groups for machine pool", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -604,6 +714,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with compute nodes when 404 (not found)", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -688,6 +799,7 @@ var _ = Describe("Hcp Machine pool", func() {
 					RespondWithJSON(http.StatusNotFound, "{}"),
 				),
 			)
+			preparePlanClusterReads("123")
 			prepareClusterRead("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
@@ -766,6 +878,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with compute nodes and update labels", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1070,6 +1183,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with compute nodes and update taints", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1278,6 +1392,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with compute nodes and remove taints", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1485,6 +1600,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with empty aws tags", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1535,6 +1651,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with aws tags, but cannot edit", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1648,6 +1765,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool without supplying tags even though cluster has tags", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1701,6 +1819,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool supplying tags even though cluster has tags", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1755,6 +1874,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with autoscaling enabled and update to compute nodes", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1918,6 +2038,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with kubelet configs", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -1973,14 +2094,69 @@ var _ = Describe("Hcp Machine pool", func() {
 			Expect(resource).To(MatchJQ(`.attributes.kubelet_configs`, "my_kubelet_config"))
 		})
 
+		It("Can create machine pool with optional http tokens", func() {
+			// Prepare the server:
+			preparePlanClusterReads("123")
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/node_pools",
+					),
+					VerifyJQ(".aws_node_pool.ec2_metadata_http_tokens", "optional"),
+					RespondWithJSON(http.StatusCreated, `{
+					"id":"my-pool",
+					"aws_node_pool":{
+					   "instance_type":"r5.xlarge",
+					   "instance_profile": "bla",
+					   "ec2_metadata_http_tokens": "optional"
+					},
+					"auto_repair": true,
+					"replicas":2,
+					"subnet":"id-1",
+					"availability_zone":"us-east-1a",
+					"version": {
+						"raw_id": "4.14.10"
+					}
+				}`),
+				),
+			)
+
+			// Run the apply command:
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+					ec2_metadata_http_tokens = "optional",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				auto_repair = true
+				version = "4.14.10"
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			// Check the state:
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", "my_pool")
+			Expect(resource).To(MatchJQ(`.attributes.aws_node_pool.ec2_metadata_http_tokens`, "optional"))
+		})
+
 		It("Can create machine pool with http tokens set and cannot edit", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
 						http.MethodPost,
 						"/api/clusters_mgmt/v1/clusters/123/node_pools",
 					),
+					VerifyJQ(".aws_node_pool.ec2_metadata_http_tokens", "required"),
 					RespondWithJSON(http.StatusCreated, `{
 					"id":"my-pool",
 					"aws_node_pool":{
@@ -2073,6 +2249,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool without http tokens set", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -2128,6 +2305,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with custom disk size set and cannot edit", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -2227,6 +2405,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		})
 
 		It("Cannot create machine pool with invalid disk size", func() {
+			preparePlanClusterReads("123")
 			// Run the apply command:
 			Terraform.Source(`
 			resource "rhcs_hcp_machine_pool" "my_pool" {
@@ -2251,6 +2430,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with capacity reservation ID", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -2309,6 +2489,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with capacity reservation ID and preference", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -2369,6 +2550,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		})
 
 		It("Fails to create machine pool with capacity reservation ID and invalid preference 'open'", func() {
+			preparePlanClusterReads("123")
 			// Run the apply command:
 			Terraform.Source(`
 			resource "rhcs_hcp_machine_pool" "my_pool" {
@@ -2394,6 +2576,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		})
 
 		It("Fails to create machine pool with capacity reservation ID and invalid preference 'none'", func() {
+			preparePlanClusterReads("123")
 			// Run the apply command:
 			Terraform.Source(`
 			resource "rhcs_hcp_machine_pool" "my_pool" {
@@ -2420,6 +2603,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with only capacity reservation preference", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -2478,6 +2662,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 		It("Can create machine pool with image type and cannot edit", func() {
 			// Prepare the server:
+			preparePlanClusterReads("123")
 			TestServer.AppendHandlers(
 				CombineHandlers(
 					VerifyRequest(
@@ -2578,6 +2763,7 @@ var _ = Describe("Hcp Machine pool", func() {
 
 	Context("Standard workers machine pool", func() {
 		BeforeEach(func() {
+			preparePlanClusterReads("123")
 			prepareClusterRead("123")
 		})
 
@@ -3623,9 +3809,131 @@ var _ = Describe("Hcp Machine pool", func() {
 		})
 	})
 
+	Context("Version skew", func() {
+		prepareControlPlaneRead := func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterUri+"123"),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "123",
+					  "name": "my-cluster",
+					  "state": "ready",
+					  "version": {
+						"raw_id": "4.14.10",
+						"channel_group": "stable"
+					  }
+					}`),
+				),
+			)
+		}
+
+		It("Can create machine pool with a version behind the control plane", func() {
+			// The control plane is read when planning, when planning again before applying, and
+			// when creating the pool:
+			prepareControlPlaneRead()
+			prepareControlPlaneRead()
+			prepareControlPlaneRead()
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/node_pools",
+					),
+					VerifyJQ(".version.id", "openshift-v4.12.20"),
+					RespondWithJSON(http.StatusCreated, `{
+					"id":"my-pool",
+					"aws_node_pool":{
+					   "instance_type":"r5.xlarge",
+					   "instance_profile": "bla"
+					},
+					"auto_repair": true,
+					"replicas":2,
+					"subnet":"id-1",
+					"availability_zone":"us-east-1a",
+					"version": {
+						"raw_id": "4.12.20"
+					}
+				}`),
+				),
+			)
+
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				version = "4.12.20"
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", "my_pool")
+			Expect(resource).To(MatchJQ(".attributes.version", "4.12.20"))
+			Expect(resource).To(MatchJQ(".attributes.current_version", "4.12.20"))
+		})
+
+		It("Fails to plan machine pool with a version too far behind the control plane", func() {
+			prepareControlPlaneRead()
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				version = "4.11.0"
+			}`)
+			runOutput := Terraform.Plan()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid machine pool version")
+			runOutput.VerifyErrorContainsSubstring("is more than 2 minor versions behind")
+		})
+
+		It("Fails to plan machine pool with a version newer than the control plane", func() {
+			prepareControlPlaneRead()
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				version = "4.15.0"
+			}`)
+			runOutput := Terraform.Plan()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("can't be newer than the control plane")
+		})
+	})
+
 	Context("Machine pool creation for non exist cluster", func() {
 		It("Fail to create machine pool if cluster is not exist", func() {
 			TestServer.AppendHandlers(
+				// The first two gets are for the version check of the plans:
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterUri+"123"),
+					RespondWithJSON(http.StatusNotFound, `{}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterUri+"123"),
+					RespondWithJSON(http.StatusNotFound, `{}`),
+				),
 				// Get is for the Read function
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, clusterUri+"123"),
@@ -3656,6 +3964,20 @@ var _ = Describe("Hcp Machine pool", func() {
 
 	Context("Import", func() {
 		It("Can import a machine pool", func() {
+			// First get is for the import, to check the type of cluster:
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterUri+"123"),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "123",
+					  "name": "my-cluster",
+					  "state": "ready",
+					  "hypershift": {
+						"enabled": true
+					  }
+					}`),
+				),
+			)
 			prepareClusterRead("123")
 			// Prepare the server:
 			TestServer.AppendHandlers(
@@ -3693,6 +4015,24 @@ var _ = Describe("Hcp Machine pool", func() {
 			Expect(resource).To(MatchJQ(".attributes.name", "my-pool"))
 			Expect(resource).To(MatchJQ(".attributes.id", "my-pool"))
 		})
+
+		It("Fails to import a machine pool of a classic cluster", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterUri+"123"),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "123",
+					  "name": "my-cluster",
+					  "state": "ready"
+					}`),
+				),
+			)
+
+			Terraform.Source(`resource "rhcs_hcp_machine_pool" "my_pool" {}`)
+			runOutput := Terraform.Import("rhcs_hcp_machine_pool.my_pool", "123,my-pool")
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("isn't a hosted control plane cluster")
+		})
 	})
 
 	Context("Machine pool delete", func() {
@@ -3723,6 +4063,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		}
 
 		createPool := func(clusterId string, poolId string) {
+			preparePlanClusterReads(clusterId)
 			prepareClusterRead(clusterId)
 			TestServer.AppendHandlers(
 				CombineHandlers(
@@ -3941,8 +4282,8 @@ var _ = Describe("Hcp Machine pool", func() {
 						"state": "ready",
 						"version": {
 							"channel_group": "stable",
-							"id": "openshitf-v4.14.0",
-							"raw_id": "4.14.0",
+							"id": "openshitf-v4.14.2",
+							"raw_id": "4.14.2",
 							"enabled": true,
 							"rosa_enabled": true,
 							"hosted_control_plane_enabled": true,
@@ -3953,6 +4294,11 @@ var _ = Describe("Hcp Machine pool", func() {
 			)
 		}
 
+		preparePlanClusterReads := func(clusterId string) {
+			prepareClusterRead(clusterId)
+			prepareClusterRead(clusterId)
+		}
+
 		preparePoolRead := func(clusterId string, poolId string) {
 			TestServer.AppendHandlers(
 				CombineHandlers(
@@ -3984,6 +4330,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		}
 
 		createPool := func(clusterId string, poolId string) {
+			preparePlanClusterReads(clusterId)
 			prepareClusterRead(clusterId)
 			TestServer.AppendHandlers(
 				CombineHandlers(
@@ -4041,6 +4388,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		It("Upgrades Machine Pool", func() {
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
+			preparePlanClusterReads(clusterId)
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
 			prepareClusterRead(clusterId)
@@ -4159,6 +4507,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		It("Does nothing if upgrade is in progress to a different version than the desired", func() {
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
+			preparePlanClusterReads(clusterId)
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
 			prepareClusterRead(clusterId)
@@ -4225,6 +4574,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		It("Cancels and upgrade for the wrong version & schedules new", func() {
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
+			preparePlanClusterReads(clusterId)
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
 			prepareClusterRead(clusterId)
@@ -4440,6 +4790,7 @@ var _ = Describe("Hcp Machine pool", func() {
 					}`, "PoolId", poolId, "ClusterId", clusterId),
 				),
 			)
+			preparePlanClusterReads(clusterId)
 			prepareClusterRead(clusterId)
 			TestServer.AppendHandlers(
 				CombineHandlers(
@@ -4487,6 +4838,33 @@ var _ = Describe("Hcp Machine pool", func() {
 			Expect(Terraform.Apply()).NotTo(BeZero())
 		})
 
+		It("is an error to plan an upgrade to a version newer than the control plane", func() {
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			prepareClusterRead(clusterId)
+
+			Terraform.Source(EvaluateTemplate(`
+			resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
+				cluster      = "{{.ClusterId}}"
+				name         = "{{.PoolId}}"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				replicas     = 3
+				subnet_id = "subnet-123"
+				autoscaling = {
+					enabled = false
+				}
+				version = "4.14.3"
+				auto_repair = true
+			}`, "PoolId", poolId, "ClusterId", clusterId))
+
+			runOutput := Terraform.Plan()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Invalid machine pool version")
+			runOutput.VerifyErrorContainsSubstring("can't be newer than the control plane")
+		})
+
 		It("older than current is allowed as long as not changed", func() {
 			prepareClusterRead(clusterId)
 			TestServer.AppendHandlers(
@@ -4567,6 +4945,7 @@ var _ = Describe("Hcp Machine pool", func() {
 			BeforeEach(func() {
 				prepareClusterRead(clusterId)
 				preparePoolRead(clusterId, poolId)
+				preparePlanClusterReads(clusterId)
 				prepareClusterRead(clusterId)
 				preparePoolRead(clusterId, poolId)
 				prepareClusterRead(clusterId)
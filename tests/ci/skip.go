@@ -0,0 +1,73 @@
+package ci
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	client "github.com/openshift-online/ocm-sdk-go"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/cms"
+)
+
+// Capabilities of the organization checked by SkipUnlessCapability
+const (
+	CapabilityHCP = "capability.organization.hypershift"
+)
+
+// skip is the function called to skip the current test. It is a variable so that tests can
+// check the skips without skipping themselves.
+var skip = func(message string) { Skip(message) }
+
+// profile is the part of the cluster profile needed to decide if a test can run
+type profile interface {
+	IsHCP() bool
+	IsPrivateLink() bool
+}
+
+// SkipUnlessHCP skips the test if the cluster profile isn't for a Hosted cluster
+func SkipUnlessHCP(profile profile) {
+	if !profile.IsHCP() {
+		skip("Test can run only on Hosted cluster")
+	}
+}
+
+// SkipUnlessClassic skips the test if the cluster profile isn't for a Classic cluster
+func SkipUnlessClassic(profile profile) {
+	if profile.IsHCP() {
+		skip("Test can run only on Classic cluster")
+	}
+}
+
+// SkipUnlessPrivateLink skips the test if the cluster profile doesn't enable private_link
+func SkipUnlessPrivateLink(profile profile) {
+	if !profile.IsPrivateLink() {
+		skip("Test can run only on cluster with private_link enabled")
+	}
+}
+
+// SkipIfPrivateLink skips the test if the cluster profile enables private_link, as the cluster
+// API can't be reached from the test runner then
+func SkipIfPrivateLink(profile profile) {
+	if profile.IsPrivateLink() {
+		skip("private_link is enabled, skipping test.")
+	}
+}
+
+// SkipUnlessCapability skips the test if the capability isn't enabled for the organization of
+// the current account
+func SkipUnlessCapability(connection *client.Connection, capability string) {
+	account, err := cms.RetrieveCurrentAccount(connection)
+	if err != nil {
+		Fail(fmt.Sprintf("Failed to retrieve the current account: %v", err))
+		return
+	}
+	orgID := account.Body().Organization().ID()
+	enabled, err := cms.OrganizationHasCapability(connection, orgID, capability)
+	if err != nil {
+		Fail(fmt.Sprintf("Failed to retrieve the capabilities of organization '%s': %v", orgID, err))
+		return
+	}
+	if !enabled {
+		skip(fmt.Sprintf("Organization '%s' doesn't have capability '%s'", orgID, capability))
+	}
+}
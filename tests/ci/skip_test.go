@@ -0,0 +1,120 @@
+package ci
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+)
+
+type fakeProfile struct {
+	hcp         bool
+	privateLink bool
+}
+
+func (p *fakeProfile) IsHCP() bool {
+	return p.hcp
+}
+
+func (p *fakeProfile) IsPrivateLink() bool {
+	return p.privateLink
+}
+
+var _ = Describe("Skip helpers", func() {
+	var skipped []string
+
+	BeforeEach(func() {
+		skipped = nil
+		skip = func(message string) {
+			skipped = append(skipped, message)
+		}
+	})
+
+	AfterEach(func() {
+		skip = func(message string) { Skip(message) }
+	})
+
+	DescribeTable("skips according to the profile",
+		func(check func(profile), p *fakeProfile, expectedSkip string) {
+			check(p)
+			if expectedSkip == "" {
+				Expect(skipped).To(BeEmpty())
+			} else {
+				Expect(skipped).To(ConsistOf(expectedSkip))
+			}
+		},
+		Entry("HCP test on HCP profile", SkipUnlessHCP, &fakeProfile{hcp: true}, ""),
+		Entry("HCP test on Classic profile", SkipUnlessHCP, &fakeProfile{}, "Test can run only on Hosted cluster"),
+		Entry("Classic test on Classic profile", SkipUnlessClassic, &fakeProfile{}, ""),
+		Entry("Classic test on HCP profile", SkipUnlessClassic, &fakeProfile{hcp: true}, "Test can run only on Classic cluster"),
+		Entry("private link test on private link profile", SkipUnlessPrivateLink, &fakeProfile{privateLink: true}, ""),
+		Entry("private link test on public profile", SkipUnlessPrivateLink, &fakeProfile{}, "Test can run only on cluster with private_link enabled"),
+		Entry("public test on public profile", SkipIfPrivateLink, &fakeProfile{}, ""),
+		Entry("public test on private link profile", SkipIfPrivateLink, &fakeProfile{privateLink: true}, "private_link is enabled, skipping test."),
+	)
+
+	Context("SkipUnlessCapability", func() {
+		var (
+			server     *Server
+			connection *client.Connection
+		)
+
+		BeforeEach(func() {
+			server = MakeTCPServer()
+			var err error
+			connection, err = client.NewConnectionBuilder().
+				URL(server.URL()).
+				RetryLimit(0).
+				Tokens(MakeTokenString("Bearer", 10*time.Minute)).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/current_account"),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Account",
+					  "id": "123",
+					  "organization": {
+					    "kind": "Organization",
+					    "id": "456"
+					  }
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/accounts_mgmt/v1/organizations/456", "fetchCapabilities=true"),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Organization",
+					  "id": "456",
+					  "capabilities": [
+					    {
+					      "kind": "Capability",
+					      "name": "capability.organization.hypershift",
+					      "value": "true"
+					    }
+					  ]
+					}`),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			connection.Close()
+			server.Close()
+		})
+
+		It("doesn't skip when the organization has the capability", func() {
+			SkipUnlessCapability(connection, CapabilityHCP)
+			Expect(skipped).To(BeEmpty())
+		})
+
+		It("skips when the organization doesn't have the capability", func() {
+			SkipUnlessCapability(connection, "capability.organization.unknown")
+			Expect(skipped).To(ConsistOf("Organization '456' doesn't have capability 'capability.organization.unknown'"))
+		})
+	})
+})
@@ -137,9 +137,7 @@ var _ = Describe("Create Account roles with shared vpc role", ci.Exclude, func()
 	})
 
 	It("create and destroy account roles for shared vpc - [id:67574]", ci.Day2, ci.Medium, func() {
-		if profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Classic cluster")
-		}
+		ci.SkipUnlessClassic(profileHandler.Profile())
 		By("Create account role without shared vpc role arn")
 		accArgs := &exec.AccountRolesArgs{
 			AccountRolePrefix: helper.StringPointer(helper.GenerateRandomName("OCP-67574", 2)),
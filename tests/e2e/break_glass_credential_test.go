@@ -28,9 +28,7 @@ var _ = Describe("Break Glass Credential", ci.Day2, ci.FeatureBreakGlassCredenti
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if !profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Hosted cluster")
-		}
+		ci.SkipUnlessHCP(profileHandler.Profile())
 
 		if !profileHandler.Profile().IsExternalAuthEnabled() {
 			Skip("Test requires external auth enabled profile")
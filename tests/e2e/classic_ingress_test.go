@@ -30,9 +30,7 @@ var _ = Describe("Classic Ingress", ci.FeatureIngress, func() {
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Classic cluster")
-		}
+		ci.SkipUnlessClassic(profileHandler.Profile())
 
 		ingressBefore, err = cms.RetrieveClusterIngress(cms.RHCSConnection, clusterID)
 		Expect(err).ToNot(HaveOccurred())
@@ -36,9 +36,7 @@ var _ = Describe("Create MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Classic cluster")
-		}
+		ci.SkipUnlessClassic(profileHandler.Profile())
 
 		mpService, err = profileHandler.Services().GetMachinePoolsService()
 		Expect(err).ToNot(HaveOccurred())
@@ -101,7 +99,7 @@ var _ = Describe("Create MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 		By("Verify the parameters of the created machinepool")
 		mpResponseBody, err := cms.RetrieveClusterMachinePool(cms.RHCSConnection, clusterID, name)
 		Expect(err).ToNot(HaveOccurred())
-		Expect(mpResponseBody.Labels()).To(Equal(creationLabels))
+		Expect(helper.NormalizeLabels(mpResponseBody.Labels())).To(Equal(creationLabels))
 
 		By("Edit the labels of the machinepool")
 		mpArgs.Labels = helper.StringMapPointer(updatingLabels)
@@ -109,7 +107,7 @@ var _ = Describe("Create MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 		Expect(err).ToNot(HaveOccurred())
 		mpResponseBody, err = cms.RetrieveClusterMachinePool(cms.RHCSConnection, clusterID, name)
 		Expect(err).ToNot(HaveOccurred())
-		Expect(mpResponseBody.Labels()).To(Equal(updatingLabels))
+		Expect(helper.NormalizeLabels(mpResponseBody.Labels())).To(Equal(updatingLabels))
 
 		By("Delete the labels of the machinepool")
 		mpArgs.Labels = helper.StringMapPointer(emptyLabels)
@@ -354,6 +352,25 @@ var _ = Describe("Create MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 		}
 	})
 
+	It("can validate a machinepool config without creating it", ci.Medium, func() {
+		By("Validate a machinepool config missing the machine type")
+		mpArgs := &exec.MachinePoolArgs{
+			Cluster:  helper.StringPointer(clusterID),
+			Replicas: helper.IntPointer(3),
+			Name:     helper.StringPointer("ocp-mp-validate"),
+		}
+		_, err := mpService.Validate(mpArgs)
+		Expect(err).To(HaveOccurred())
+		helper.ExpectTFErrorContains(err, "machine_type")
+
+		By("Check that no machinepool was created")
+		resp, err := cms.ListMachinePool(cms.RHCSConnection, clusterID)
+		Expect(err).ToNot(HaveOccurred())
+		for _, mp := range resp.Items().Slice() {
+			Expect(mp.ID()).ToNot(Equal("ocp-mp-validate"))
+		}
+	})
+
 	It("can create single-az machinepool for multi-az cluster - [id:65063]", ci.High, func() {
 		if !profileHandler.Profile().IsMultiAZ() {
 			Skip("The test is configured for MultiAZ cluster only")
@@ -635,6 +652,12 @@ var _ = Describe("Create MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 			Expect(tagValue).To(BeElementOf(resp.AWS().Tags()[tagKey]))
 		}
 
+		By("Check the machinepool tags are in the output")
+		output, err := mpService.Output()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.MachinePools).To(HaveLen(1))
+		Expect(output.MachinePools[0].Tags).To(Equal(validTags))
+
 		By("Update the machinepool tags is not allowed")
 		validTags["tagKey2"] = "tagValue2"
 		_, err = mpService.Apply(mpArgs)
@@ -731,6 +754,13 @@ var _ = Describe("Create MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 		Expect(err).To(HaveOccurred())
 		Expect(helper.GetTFErrorMessage(err)).Should(ContainSubstring("Tags that begin with 'aws:' are reserved"))
 
+		By("Create machinepool creation plan with an over-long tag value")
+		mpArgs.Tags = helper.StringMapPointer(map[string]string{
+			"tagKey": strings.Repeat("v", 257),
+		})
+		_, err = mpService.Apply(mpArgs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("value of tag 'tagKey' is longer than 256 characters"))
 	})
 })
 
@@ -744,9 +774,7 @@ var _ = Describe("Import MachinePool", ci.Day2, ci.FeatureImport, func() {
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Classic cluster")
-		}
+		ci.SkipUnlessClassic(profileHandler.Profile())
 
 		mpService, err = profileHandler.Services().GetMachinePoolsService()
 		Expect(err).ToNot(HaveOccurred())
@@ -807,7 +835,7 @@ var _ = Describe("Edit MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 		profileHandler                 profilehandler.ProfileHandler
 		dmpService                     exec.MachinePoolService
 		mpService                      exec.MachinePoolService
-		defaultMachinePoolName         = "worker"
+		defaultMachinePoolName         = constants.DefaultMachinePoolName(constants.ROSA_CLASSIC.String())
 		defaultMachinepoolResponse     *cmsv1.MachinePool
 		originalDefaultMachinepoolArgs *exec.MachinePoolArgs
 	)
@@ -817,9 +845,7 @@ var _ = Describe("Edit MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Classic cluster")
-		}
+		ci.SkipUnlessClassic(profileHandler.Profile())
 
 		dmpTFWorkspace := helper.GenerateRandomName("dft-"+profileHandler.Profile().GetName(), 2)
 		dmpService, err = exec.NewMachinePoolService(dmpTFWorkspace, profileHandler.Profile().GetClusterType())
@@ -901,7 +927,7 @@ var _ = Describe("Edit MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 			taint0 := map[string]string{"key": "k1", "value": "val", "schedule_type": constants.NoExecute}
 			taint1 := map[string]string{"key": "k2", "value": "val2", "schedule_type": constants.NoSchedule}
 			taints := []map[string]string{taint0, taint1}
-			defaultMPName := "worker"
+			defaultMPName := constants.DefaultMachinePoolName(constants.ROSA_CLASSIC.String())
 
 			dmpArgFromMachinepoolForTesting, err := dmpService.ReadTFVars()
 			Expect(err).ToNot(HaveOccurred())
@@ -951,7 +977,7 @@ var _ = Describe("Edit MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 			By("Verify the parameters of the created machinepool")
 			mpResponseBody, err := cms.RetrieveClusterMachinePool(cms.RHCSConnection, clusterID, defaultMPName)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(mpResponseBody.Labels()).To(Equal(creationLabels))
+			Expect(helper.NormalizeLabels(mpResponseBody.Labels())).To(Equal(creationLabels))
 
 			By("Create an additional machinepool")
 			replicas := 3
@@ -974,7 +1000,7 @@ var _ = Describe("Edit MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			By("Verify the parameters of the default machinepool")
-			mpResponseBody, err = cms.RetrieveClusterMachinePool(cms.RHCSConnection, clusterID, "worker")
+			mpResponseBody, err = cms.RetrieveClusterMachinePool(cms.RHCSConnection, clusterID, defaultMPName)
 			Expect(err).ToNot(HaveOccurred())
 			respTaints := mpResponseBody.Taints()
 			for index, taint := range respTaints {
@@ -993,7 +1019,7 @@ var _ = Describe("Destroy MachinePool", ci.Day3, ci.FeatureMachinepool, func() {
 		defaultMachinePoolArgs     *exec.MachinePoolArgs
 		mpService                  exec.MachinePoolService
 		defaultMachinepoolResponse *cmsv1.MachinePool
-		defaultMachinePoolName     = "worker"
+		defaultMachinePoolName     = constants.DefaultMachinePoolName(constants.ROSA_CLASSIC.String())
 	)
 
 	BeforeEach(func() {
@@ -1001,9 +1027,7 @@ var _ = Describe("Destroy MachinePool", ci.Day3, ci.FeatureMachinepool, func() {
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Classic cluster")
-		}
+		ci.SkipUnlessClassic(profileHandler.Profile())
 
 		dmpTFWorkspace := helper.GenerateRandomName("dft-"+profileHandler.Profile().GetName(), 2)
 		dmpService, err = exec.NewMachinePoolService(dmpTFWorkspace, profileHandler.Profile().GetClusterType())
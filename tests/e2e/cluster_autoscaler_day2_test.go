@@ -57,9 +57,7 @@ var _ = Describe("Cluster Autoscaler", ci.Day2, ci.FeatureClusterAutoscaler, fun
 		}
 	})
 	It("can be added/destroyed to Classic cluster - [id:69137]", ci.High, func() {
-		if profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Classic cluster")
-		}
+		ci.SkipUnlessClassic(profileHandler.Profile())
 
 		var err error
 		caService, err = profileHandler.Services().GetClusterAutoscalerService()
@@ -150,9 +148,7 @@ var _ = Describe("Cluster Autoscaler", ci.Day2, ci.FeatureClusterAutoscaler, fun
 	It("can be created/edited/deleted to HCP cluster - [id:72524][id:72525]",
 		ci.High, ci.Exclude, // Delete and create cluster autoscaler are not currently supported for HCP
 		func() {
-			if !profileHandler.Profile().IsHCP() {
-				Skip("Test can run only on Hosted cluster")
-			}
+			ci.SkipUnlessHCP(profileHandler.Profile())
 
 			var err error
 			caService, err = profileHandler.Services().GetClusterAutoscalerService()
@@ -231,9 +227,7 @@ var _ = Describe("Cluster Autoscaler", ci.Day2, ci.FeatureClusterAutoscaler, fun
 
 	// Delete and create cluster autoscaler are not currently supported for HCP
 	It("can be validated against HCP cluster - [id:72526]", ci.Medium, ci.Exclude, func() {
-		if !profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Hosted cluster")
-		}
+		ci.SkipUnlessHCP(profileHandler.Profile())
 
 		var err error
 		caService, err = profileHandler.Services().GetClusterAutoscalerService()
@@ -307,9 +301,7 @@ var _ = Describe("Cluster Autoscaler", ci.Day2, ci.FeatureClusterAutoscaler, fun
 	})
 
 	It("can be validated against Classic cluster - [id:76199]", ci.Medium, func() {
-		if profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Classic cluster")
-		}
+		ci.SkipUnlessClassic(profileHandler.Profile())
 
 		defaultClusterAutoscalerArgs := func() *exec.ClusterAutoscalerArgs {
 			max := 1
@@ -120,9 +120,7 @@ var _ = Describe("Edit cluster", ci.Day2, func() {
 		})
 
 		It("registry config - [id:76500]", ci.High, ci.FeatureClusterRegistryConfig, func() {
-			if !profileHandler.Profile().IsHCP() {
-				Skip("Test can run only on Hosted cluster")
-			}
+			ci.SkipUnlessHCP(profileHandler.Profile())
 
 			getCMSClusterRegistryConfig := func() *cmsv1.ClusterRegistryConfig {
 				resp, err := cms.RetrieveClusterDetail(cms.RHCSConnection, clusterID)
@@ -210,9 +208,7 @@ var _ = Describe("Edit cluster", ci.Day2, func() {
 
 	Context("validate", func() {
 		BeforeEach(func() {
-			if !profileHandler.Profile().IsHCP() {
-				Skip("Test can run only on Hosted cluster")
-			}
+			ci.SkipUnlessHCP(profileHandler.Profile())
 		})
 
 		validateClusterArg := func(updateFields func(args *exec.ClusterArgs), validateFunc func(output string, err error)) {
@@ -591,9 +587,7 @@ var _ = Describe("Edit cluster", ci.Day2, func() {
 		It("security groups - [id:69145]",
 			ci.Exclude, ci.Day2,
 			func() {
-				if profileHandler.Profile().IsHCP() {
-					Skip("Test can run only on Classic cluster")
-				}
+				ci.SkipUnlessClassic(profileHandler.Profile())
 				clusterService, err := profileHandler.Services().GetClusterService()
 				Expect(err).ToNot(HaveOccurred())
 				output, err := clusterService.Output()
@@ -626,9 +620,7 @@ var _ = Describe("Edit cluster", ci.Day2, func() {
 			})
 
 		It("registry config - [id:76501]", ci.Medium, ci.FeatureClusterRegistryConfig, func() {
-			if !profileHandler.Profile().IsHCP() {
-				Skip("Test can run only on Hosted cluster")
-			}
+			ci.SkipUnlessHCP(profileHandler.Profile())
 			registry := helper.GetRegistry(8090)
 			registries := helper.GetRegistries(8090, 8091)
 			duplicatedRegistries := []string{
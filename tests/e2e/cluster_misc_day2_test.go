@@ -53,9 +53,7 @@ var _ = Describe("Cluster miscellaneous", func() {
 
 	It("should validate custom property operations on cluster - [id:64907]",
 		ci.Day2, ci.Medium, ci.FeatureClusterMisc, func() {
-			if profileHandler.Profile().IsHCP() {
-				Skip("Test can run only on Classic cluster")
-			}
+			ci.SkipUnlessClassic(profileHandler.Profile())
 
 			By("Adding additional custom property to the existing cluster")
 			updatedCustomProperties := profilehandler.CustomProperties
@@ -82,9 +80,7 @@ var _ = Describe("Cluster miscellaneous", func() {
 		})
 
 	It("can edit/delete cluster properties - [id:72451]", ci.Day2, ci.Medium, ci.FeatureClusterMisc, func() {
-		if !profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Hosted cluster")
-		}
+		ci.SkipUnlessHCP(profileHandler.Profile())
 
 		updatedCustomProperties := helper.CopyStringMap(originalCustomProperties)
 
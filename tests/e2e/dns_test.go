@@ -28,9 +28,7 @@ var _ = Describe("DNS Domain", func() {
 
 	It("can create and destroy dnsdomain - [id:67570]",
 		ci.Day2, ci.Medium, ci.FeatureIDP, func() {
-			if profileHandler.Profile().IsHCP() {
-				Skip("Test can run only on Classic cluster")
-			}
+			ci.SkipUnlessClassic(profileHandler.Profile())
 
 			By("Retrieve DNS creation args")
 			dnsArgs, err := dnsService.ReadTFVars()
@@ -20,9 +20,7 @@ var _ = Describe("External Authentication", ci.Day1, ci.FeatureExternalAuth, fun
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if !profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Hosted cluster")
-		}
+		ci.SkipUnlessHCP(profileHandler.Profile())
 
 		if !profileHandler.Profile().IsExternalAuthEnabled() {
 			Skip("Test requires external auth enabled profile")
@@ -24,9 +24,7 @@ var _ = Describe("HCP ImageMirror", ci.Day2, func() {
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if !profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Hosted cluster")
-		}
+		ci.SkipUnlessHCP(profileHandler.Profile())
 
 		imageMirrorService, err = profileHandler.Services().GetImageMirrorService()
 		Expect(err).ToNot(HaveOccurred())
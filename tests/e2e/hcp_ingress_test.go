@@ -44,9 +44,7 @@ var _ = Describe("HCP Ingress", ci.FeatureIngress, ci.Day2, func() {
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if !profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Hosted cluster")
-		}
+		ci.SkipUnlessHCP(profileHandler.Profile())
 
 		ingressBefore, err = cms.RetrieveClusterIngress(cms.RHCSConnection, clusterID)
 		Expect(err).ToNot(HaveOccurred())
@@ -36,9 +36,7 @@ var _ = Describe("HCP MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
 
-		if !profileHandler.Profile().IsHCP() {
-			Skip("Test can run only on Hosted cluster")
-		}
+		ci.SkipUnlessHCP(profileHandler.Profile())
 
 		mpService, err = profileHandler.Services().GetMachinePoolsService()
 		Expect(err).ToNot(HaveOccurred())
@@ -384,7 +382,7 @@ var _ = Describe("HCP MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 				Expect(taint.Value()).To(Equal(taints[index]["value"]))
 			}
 			Expect(mpResponseBody.AutoRepair()).To(BeFalse())
-			Expect(mpResponseBody.Labels()).To(Equal(labels))
+			Expect(helper.NormalizeLabels(mpResponseBody.Labels())).To(Equal(labels))
 
 			By("Update labels/taints/autorepair")
 			taints = append(taints, map[string]string{"key": "t2", "value": "", "schedule_type": constants.NoExecute})
@@ -406,7 +404,7 @@ var _ = Describe("HCP MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 				Expect(taint.Value()).To(Equal(taints[index]["value"]))
 			}
 			Expect(mpResponseBody.AutoRepair()).To(BeTrue())
-			Expect(mpResponseBody.Labels()).To(Equal(labels))
+			Expect(helper.NormalizeLabels(mpResponseBody.Labels())).To(Equal(labels))
 
 			By("Remove labels/taints")
 			mpArgs.Labels = nil
@@ -763,6 +761,58 @@ var _ = Describe("HCP MachinePool", ci.Day2, ci.FeatureMachinepool, func() {
 
 		})
 
+	It("can be created with auto repair and expose the node pool fields",
+		ci.High, func() {
+			By("Create machinepool")
+			name := helper.GenerateRandomName("np-autorepair", 2)
+			mpArgs := getDefaultMPArgs(name)
+			_, err := mpService.Apply(mpArgs)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Verify the node pool fields in the output")
+			mpsOut, err := mpService.Output()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mpsOut.MachinePools).To(HaveLen(1))
+			mpOut := mpsOut.MachinePools[0]
+			Expect(mpOut.Name).To(Equal(name))
+			Expect(mpOut.SubnetID).To(Equal(*mpArgs.SubnetID))
+			Expect(mpOut.AutoRepair).To(BeTrue())
+			Expect(mpOut.OpenshiftVersion).ToNot(BeEmpty())
+
+			By("Verify attributes are correctly set")
+			mpResponseBody, err := cms.RetrieveClusterNodePool(cms.RHCSConnection, clusterID, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mpResponseBody.AutoRepair()).To(BeTrue())
+			Expect(mpResponseBody.Subnet()).To(Equal(mpOut.SubnetID))
+			Expect(mpResponseBody.Version().RawID()).To(Equal(mpOut.OpenshiftVersion))
+		})
+
+	It("uses the OCM auto repair default when it isn't set",
+		ci.Medium, func() {
+			By("Create machinepool without auto repair")
+			name := helper.GenerateRandomName("np-autorepair", 2)
+			mpArgs := getDefaultMPArgs(name)
+			mpArgs.AutoRepair = nil
+			_, err := mpService.Apply(mpArgs)
+			Expect(err).ToNot(HaveOccurred())
+
+			By("Verify auto repair is enabled")
+			mpResponseBody, err := cms.RetrieveClusterNodePool(cms.RHCSConnection, clusterID, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mpResponseBody.AutoRepair()).To(BeTrue())
+			mpsOut, err := mpService.Output()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mpsOut.MachinePools[0].AutoRepair).To(BeTrue())
+
+			By("Disable auto repair")
+			mpArgs.AutoRepair = helper.BoolPointer(false)
+			_, err = mpService.Apply(mpArgs)
+			Expect(err).ToNot(HaveOccurred())
+			mpResponseBody, err = cms.RetrieveClusterNodePool(cms.RHCSConnection, clusterID, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mpResponseBody.AutoRepair()).To(BeFalse())
+		})
+
 	It("can create with image type set - [id:87302]",
 		ci.Critical, ci.FeatureMachinepoolImageType, func() {
 			By("Create machinepool without an image type set")
@@ -12,7 +12,6 @@ import (
 	. "github.com/openshift-online/ocm-sdk-go/testing"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/ci"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/cms"
-	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/config"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/constants"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/exec"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/helper"
@@ -30,6 +29,31 @@ type IDPServices struct {
 	ldap,
 	multi_idp,
 	openid exec.IDPService
+
+	// named holds the additional idp services keyed by idp name, so that
+	// several idps of the same type can be managed in a case
+	named map[string]exec.IDPService
+}
+
+// all returns all the idp services which were initialized
+func (svcs *IDPServices) all() (services []exec.IDPService) {
+	for _, svc := range []exec.IDPService{
+		svcs.htpasswd,
+		svcs.github,
+		svcs.gitlab,
+		svcs.google,
+		svcs.ldap,
+		svcs.multi_idp,
+		svcs.openid,
+	} {
+		if svc != nil {
+			services = append(services, svc)
+		}
+	}
+	for _, svc := range svcs.named {
+		services = append(services, svc)
+	}
+	return
 }
 
 var (
@@ -105,43 +129,41 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 	var (
 		profileHandler profilehandler.ProfileHandler
 		idpServices    = IDPServices{}
+		kubeconfigDir  string
 	)
 
 	BeforeEach(func() {
 		var err error
 		profileHandler, err = profilehandler.NewProfileHandlerFromYamlFile()
 		Expect(err).ToNot(HaveOccurred())
-		idpServices = IDPServices{}
+		idpServices = IDPServices{named: map[string]exec.IDPService{}}
+
+		// Each case logs in with its own kubeconfigs, removed once it is done:
+		var cleanup func()
+		kubeconfigDir, cleanup, err = openshift.NewTempKubeconfigDir()
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(cleanup)
 	})
 
-	AfterEach(func() {
-		if idpServices.htpasswd != nil {
-			idpServices.htpasswd.Destroy()
-			idpServices.htpasswd = nil
-		}
-		if idpServices.github != nil {
-			idpServices.github.Destroy()
-			idpServices.github = nil
-		}
-		if idpServices.gitlab != nil {
-			idpServices.gitlab.Destroy()
-			idpServices.gitlab = nil
-		}
-		if idpServices.google != nil {
-			idpServices.google.Destroy()
-			idpServices.google = nil
+	// destroyIDP destroys the idp(s) managed by the service and waits for OCM to
+	// remove them so that following cases don't see them anymore
+	destroyIDP := func(svc exec.IDPService) {
+		idpOutput, _ := svc.Output()
+		svc.Destroy()
+		if idpOutput == nil {
+			return
 		}
-		if idpServices.ldap != nil {
-			idpServices.ldap.Destroy()
-			idpServices.ldap = nil
-		}
-		if idpServices.multi_idp != nil {
-			idpServices.multi_idp.Destroy()
-			idpServices.multi_idp = nil
+		for _, idpID := range []string{idpOutput.ID, idpOutput.GoogleID, idpOutput.LDAPID} {
+			if idpID != "" {
+				err := cms.WaitForIDPGone(cms.RHCSConnection, clusterID, idpID, 5*time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+			}
 		}
-		if idpServices.openid != nil {
-			idpServices.openid.Destroy()
-			idpServices.openid = nil
+	}
+
+	AfterEach(func() {
+		for _, svc := range idpServices.all() {
+			destroyIDP(svc)
 		}
 	})
 
@@ -171,6 +193,9 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 				// this condition is for cases where the cluster profile
 				// has private_link enabled, then regular login won't work
 				if !profileHandler.Profile().IsPrivateLink() {
+					err = cms.WaitForHtpasswdUser(cms.RHCSConnection, clusterID, idpOutput.ID, defaultHTPUsername, 2*time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+
 					getResp, err := cms.RetrieveClusterDetail(cms.RHCSConnection, clusterID)
 					Expect(err).ToNot(HaveOccurred())
 					server := getResp.Body().API().URL()
@@ -182,7 +207,7 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 						ClusterID: clusterID,
 						AdditionalFlags: []string{
 							"--insecure-skip-tls-verify",
-							fmt.Sprintf("--kubeconfig %s", path.Join(config.GetKubeConfigDir(), fmt.Sprintf("%s.%s", clusterID, defaultHTPUsername))),
+							fmt.Sprintf("--kubeconfig %s", path.Join(kubeconfigDir, fmt.Sprintf("%s.%s", clusterID, defaultHTPUsername))),
 						},
 						Timeout: 7,
 					}
@@ -192,6 +217,39 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 					Logger.Infof("private_link is enabled, skipping login command check.")
 				}
 			})
+			It("multiple htpasswd idps can be managed independently", ci.Medium, func() {
+				idpNames := []string{"tf-htpasswd-idp-1", "tf-htpasswd-idp-2"}
+				idpIDs := map[string]string{}
+
+				By("Create two htpasswd idps for an existing cluster")
+				for _, idpName := range idpNames {
+					svc, err := profileHandler.Services().GetNamedIDPService(constants.IDPHTPassword, idpName)
+					Expect(err).ToNot(HaveOccurred())
+					idpServices.named[idpName] = svc
+
+					_, err = svc.Apply(getDefaultHTPasswordArgs(idpName))
+					Expect(err).ToNot(HaveOccurred())
+					idpOutput, err := svc.Output()
+					Expect(err).ToNot(HaveOccurred())
+					idpIDs[idpName] = idpOutput.ID
+				}
+				Expect(idpIDs[idpNames[0]]).ToNot(Equal(idpIDs[idpNames[1]]))
+
+				By("Destroy the first htpasswd idp")
+				destroyIDP(idpServices.named[idpNames[0]])
+				delete(idpServices.named, idpNames[0])
+
+				By("Check the second htpasswd idp is still there")
+				resp, err := cms.RetrieveIDP(cms.RHCSConnection, clusterID, idpIDs[idpNames[1]])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Status()).To(Equal(http.StatusOK))
+				Expect(resp.Body().Name()).To(Equal(idpNames[1]))
+
+				By("Destroy the second htpasswd idp")
+				destroyIDP(idpServices.named[idpNames[1]])
+				delete(idpServices.named, idpNames[1])
+			})
+
 			It("Update htpasswd idp - [id:73154]", ci.High, func() {
 				htpIDPName := "tf-htpassed-idp"
 
@@ -266,6 +324,83 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 					Expect(existed).To(BeTrue())
 				}
 			})
+			It("Rotate the password of a htpasswd user", ci.Medium, func() {
+				By("Create htpasswd idp for an existing cluster")
+				idpParam := getDefaultHTPasswordArgs("tf-htpasswd-rotate-password")
+				_, err := idpServices.htpasswd.Apply(idpParam)
+				Expect(err).ToNot(HaveOccurred())
+				idpOutput, err := idpServices.htpasswd.Output()
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Rotate the password of the user")
+				newPassword := helper.GenerateRandomPassword(15)
+				err = idpServices.htpasswd.UpdateHtpasswdPassword(defaultHTPUsername, newPassword)
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Check the idp was updated in place")
+				newIDPOutput, err := idpServices.htpasswd.Output()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newIDPOutput.ID).To(Equal(idpOutput.ID))
+				resource, err := idpServices.htpasswd.GetStateResource("rhcs_identity_provider", "htpasswd_idp")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resource).To(MatchJQ(fmt.Sprintf(`.instances[0].attributes.htpasswd.users[] | select(.username == "%s") .password`, defaultHTPUsername), newPassword))
+
+				By("Login with the new password")
+				if profileHandler.Profile().IsPrivateLink() {
+					Logger.Infof("private_link is enabled, skipping login command check.")
+					return
+				}
+				err = cms.WaitForHtpasswdUser(cms.RHCSConnection, clusterID, idpOutput.ID, defaultHTPUsername, 2*time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+
+				getResp, err := cms.RetrieveClusterDetail(cms.RHCSConnection, clusterID)
+				Expect(err).ToNot(HaveOccurred())
+				ocAtter := &openshift.OcAttributes{
+					Server:    getResp.Body().API().URL(),
+					Username:  defaultHTPUsername,
+					Password:  newPassword,
+					ClusterID: clusterID,
+					AdditionalFlags: []string{
+						"--insecure-skip-tls-verify",
+						fmt.Sprintf("--kubeconfig %s", path.Join(kubeconfigDir, fmt.Sprintf("%s.%s", clusterID, defaultHTPUsername))),
+					},
+					Timeout: 7,
+				}
+				_, err = openshift.OcLogin(*ocAtter)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("Remove a single htpasswd user", ci.Medium, func() {
+				By("Create htpasswd idp with two users for an existing cluster")
+				userName2 := "my-admin-user2"
+				idpParam := getDefaultHTPasswordArgs("tf-htpasswd-remove-user")
+				htpUsers := append(*idpParam.HtpasswdUsers, exec.HTPasswordUser{
+					Username: helper.StringPointer(userName2),
+					Password: helper.StringPointer(helper.GenerateRandomPassword(15)),
+				})
+				idpParam.HtpasswdUsers = &htpUsers
+				_, err := idpServices.htpasswd.Apply(idpParam)
+				Expect(err).ToNot(HaveOccurred())
+				idpOutput, err := idpServices.htpasswd.Output()
+				Expect(err).ToNot(HaveOccurred())
+
+				htpasswdUsersList, _ := cms.ListHtpasswdUsers(cms.RHCSConnection, clusterID, idpOutput.ID)
+				Expect(htpasswdUsersList.Status()).To(Equal(http.StatusOK))
+				Expect(htpasswdUsersList.Items().Len()).To(Equal(2))
+
+				By("Remove the second user")
+				err = idpServices.htpasswd.RemoveHtpasswdUser(userName2)
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Check the idp was updated in place and only the first user remains")
+				newIDPOutput, err := idpServices.htpasswd.Output()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newIDPOutput.ID).To(Equal(idpOutput.ID))
+				htpasswdUsersList, _ = cms.ListHtpasswdUsers(cms.RHCSConnection, clusterID, idpOutput.ID)
+				Expect(htpasswdUsersList.Status()).To(Equal(http.StatusOK))
+				Expect(htpasswdUsersList.Items().Len()).To(Equal(1))
+				Expect(htpasswdUsersList.Items().Slice()[0].Username()).To(Equal(defaultHTPUsername))
+			})
 		})
 
 		Context("LDAP", func() {
@@ -309,7 +444,7 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 							ClusterID: clusterID,
 							AdditionalFlags: []string{
 								"--insecure-skip-tls-verify",
-								fmt.Sprintf("--kubeconfig %s", path.Join(config.GetKubeConfigDir(), fmt.Sprintf("%s.%s", clusterID, defaultLDAPUsername))),
+								fmt.Sprintf("--kubeconfig %s", path.Join(kubeconfigDir, fmt.Sprintf("%s.%s", clusterID, defaultLDAPUsername))),
 							},
 							Timeout: 7,
 						}
@@ -407,11 +542,47 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 				Expect(resp.Status()).To(Equal(http.StatusOK))
 			})
 		})
-		Context("Multi IDPs", func() {
+		Context("OpenID", func() {
 			BeforeEach(func() {
-				if profileHandler.Profile().IsPrivateLink() {
-					Skip("private_link is enabled, skipping test.")
+				var err error
+				idpServices.openid, err = profileHandler.Services().GetIDPService(constants.IDPOpenID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("will succeed with multi-value claims", ci.High, func() {
+				By("Create OpenID idp with several claims of each kind for an existing cluster")
+				claimEmail := []string{"email", "mail"}
+				claimName := []string{"name", "display_name"}
+				claimPreferredUsername := []string{"preferred_username", "login"}
+				idpParam := &exec.IDPArgs{
+					ClusterID:              helper.StringPointer(clusterID),
+					Name:                   helper.StringPointer("tf-openid-claims-test"),
+					ClientID:               helper.StringPointer(helper.GenerateRandomStringWithSymbols(30)),
+					ClientSecret:           helper.StringPointer(helper.GenerateRandomStringWithSymbols(20)),
+					Issuer:                 helper.StringPointer("https://accounts.google.com"),
+					ClaimEmail:             &claimEmail,
+					ClaimName:              &claimName,
+					ClaimPreferredUsername: &claimPreferredUsername,
 				}
+				_, err := idpServices.openid.Apply(idpParam)
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Check the claims of the openid idp in OCM")
+				idpOutput, err := idpServices.openid.Output()
+				Expect(err).ToNot(HaveOccurred())
+
+				resp, err := cms.RetrieveClusterIDPDetail(cms.RHCSConnection, clusterID, idpOutput.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Status()).To(Equal(http.StatusOK))
+				claims := resp.Body().OpenID().Claims()
+				Expect(claims.Email()).To(Equal(claimEmail))
+				Expect(claims.Name()).To(Equal(claimName))
+				Expect(claims.PreferredUsername()).To(Equal(claimPreferredUsername))
+			})
+		})
+		Context("Multi IDPs", func() {
+			BeforeEach(func() {
+				ci.SkipIfPrivateLink(profileHandler.Profile())
 
 				defaultLDAPUsername = "newton"
 				defaultLDAPPassword = "password"
@@ -452,7 +623,7 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 					ClusterID: clusterID,
 					AdditionalFlags: []string{
 						"--insecure-skip-tls-verify",
-						fmt.Sprintf("--kubeconfig %s", path.Join(config.GetKubeConfigDir(), fmt.Sprintf("%s.%s", clusterID, defaultLDAPUsername))),
+						fmt.Sprintf("--kubeconfig %s", path.Join(kubeconfigDir, fmt.Sprintf("%s.%s", clusterID, defaultLDAPUsername))),
 					},
 					Timeout: 7,
 				}
@@ -475,7 +646,7 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 					ClusterID: clusterID,
 					AdditionalFlags: []string{
 						"--insecure-skip-tls-verify",
-						fmt.Sprintf("--kubeconfig %s", path.Join(config.GetKubeConfigDir(), fmt.Sprintf("%s.%s", clusterID, username))),
+						fmt.Sprintf("--kubeconfig %s", path.Join(kubeconfigDir, fmt.Sprintf("%s.%s", clusterID, username))),
 					},
 					Timeout: 10,
 				}
@@ -516,7 +687,7 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 					ClusterID: clusterID,
 					AdditionalFlags: []string{
 						"--insecure-skip-tls-verify",
-						fmt.Sprintf("--kubeconfig %s", path.Join(config.GetKubeConfigDir(), fmt.Sprintf("%s.%s", clusterID, defaultHTPUsername))),
+						fmt.Sprintf("--kubeconfig %s", path.Join(kubeconfigDir, fmt.Sprintf("%s.%s", clusterID, defaultHTPUsername))),
 					},
 					Timeout: 10,
 				}
@@ -547,7 +718,7 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 					ClusterID: clusterID,
 					AdditionalFlags: []string{
 						"--insecure-skip-tls-verify",
-						fmt.Sprintf("--kubeconfig %s", path.Join(config.GetKubeConfigDir(), fmt.Sprintf("%s.%s", clusterID, defaultHTPUsername))),
+						fmt.Sprintf("--kubeconfig %s", path.Join(kubeconfigDir, fmt.Sprintf("%s.%s", clusterID, defaultHTPUsername))),
 					},
 					Timeout: 10,
 				}
@@ -830,6 +1001,49 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 			})
 	})
 
+	Describe("import", func() {
+		It("an htpasswd idp created out of terraform can be imported",
+			ci.Medium, ci.FeatureImport, func() {
+				var err error
+				idpServices.htpasswd, err = profileHandler.Services().GetIDPService(constants.IDPHTPassword)
+				Expect(err).ToNot(HaveOccurred())
+				idpName := "tf-imported-htpasswd"
+
+				By("Create htpasswd idp using OCM api")
+				requestBody, err := cmsv1.NewIdentityProvider().
+					Type("HTPasswdIdentityProvider").
+					Name(idpName).
+					MappingMethod("claim").
+					Htpasswd(cmsv1.NewHTPasswdIdentityProvider().
+						Users(cmsv1.NewHTPasswdUserList().Items(
+							cmsv1.NewHTPasswdUser().
+								Username(defaultHTPUsername).
+								Password(defaultHTPPassword),
+						))).
+					Build()
+				Expect(err).ToNot(HaveOccurred())
+				res, err := cms.CreateClusterIDP(cms.RHCSConnection, clusterID, requestBody)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Status()).To(Equal(http.StatusCreated))
+				idpID := res.Body().ID()
+
+				By("Import the idp into the htpasswd manifests")
+				idpParam := getDefaultHTPasswordArgs(idpName)
+				err = idpServices.htpasswd.WriteTFVars(idpParam)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = idpServices.htpasswd.Import(clusterID, idpID)
+				Expect(err).ToNot(HaveOccurred())
+				idpOutput, err := idpServices.htpasswd.Output()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(idpOutput.ID).To(Equal(idpID))
+
+				By("Check a plan after the import has no changes")
+				output, err := idpServices.htpasswd.Plan(idpParam)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output).To(ContainSubstring("No changes. Your infrastructure matches the configuration."))
+			})
+	})
+
 	Describe("reconciliation", func() {
 		var (
 			gitlabIdpOcmAPI *cmsv1.IdentityProviderBuilder
@@ -866,7 +1080,7 @@ var _ = Describe("Identity Providers", ci.Day2, ci.FeatureIDP, func() {
 					ClusterID: clusterID,
 					AdditionalFlags: []string{
 						"--insecure-skip-tls-verify",
-						fmt.Sprintf("--kubeconfig %s", path.Join(config.GetKubeConfigDir(), fmt.Sprintf("%s.%s", clusterID, defaultLDAPUsername))),
+						fmt.Sprintf("--kubeconfig %s", path.Join(kubeconfigDir, fmt.Sprintf("%s.%s", clusterID, defaultLDAPUsername))),
 					},
 					Timeout: 7,
 				}
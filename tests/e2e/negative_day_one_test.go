@@ -84,9 +84,7 @@ var _ = Describe("Negative Tests", Ordered, ContinueOnFailure, func() {
 
 	Describe("cluster admin", ci.Day1Negative, func() {
 		BeforeEach(OncePerOrdered, func() {
-			if profileHandler.Profile().IsHCP() {
-				Skip("Test can run only on Classic cluster")
-			}
+			ci.SkipUnlessClassic(profileHandler.Profile())
 			if !profileHandler.Profile().IsAdminEnabled() {
 				Skip("The tests configured for cluster admin only")
 			}
@@ -135,9 +133,7 @@ var _ = Describe("Negative Tests", Ordered, ContinueOnFailure, func() {
 
 	Describe("Create HCP cluster", ci.Day1Negative, func() {
 		BeforeEach(OncePerOrdered, func() {
-			if !profileHandler.Profile().IsHCP() {
-				Skip("Test can run only on Hosted cluster")
-			}
+			ci.SkipUnlessHCP(profileHandler.Profile())
 		})
 
 		It("validate required fields - [id:72445]", ci.High, func() {
@@ -353,6 +349,13 @@ var _ = Describe("Negative Tests", Ordered, ContinueOnFailure, func() {
 					"name": "***",
 				})
 			}, "Attribute value '***' of 'aws.tags.name' invalid")
+
+			By("Create cluster with over-long tag key")
+			validateClusterArgAgainstErrorSubstrings(func(args *exec.ClusterArgs) {
+				args.Tags = helper.StringMapPointer(map[string]string{
+					strings.Repeat("k", 129): "cluster",
+				})
+			}, "is longer than 128 characters")
 		})
 
 		It("validate network fields - [id:72468]", ci.Medium, func() {
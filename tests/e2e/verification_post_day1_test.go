@@ -365,7 +365,7 @@ var _ = Describe("Verify cluster", func() {
 		expectedKeyArn := kmsOutput.KeyARN
 		if profile.IsHCP() {
 			for key, value := range listRSresp.Body().Resources() {
-				if strings.Contains(key, "workers") {
+				if strings.Contains(key, constants.DefaultMachinePoolName(profile.GetClusterType().Name)) {
 					Expect(value).Should(ContainSubstring(`"encryptionKey":"` + expectedKeyArn + `"`))
 				}
 			}
@@ -516,7 +516,7 @@ var _ = Describe("Verify cluster", func() {
 
 		for _, np := range npList {
 			Expect(np.ID()).ToNot(BeNil())
-			if strings.HasPrefix(np.ID(), constants.DefaultNodePoolName) {
+			if strings.HasPrefix(np.ID(), constants.DefaultMachinePoolName(profile.GetClusterType().Name)) {
 				By("Get the details of the nodepool")
 				npRespBody, err := cms.RetrieveClusterNodePool(cms.RHCSConnection, clusterID, np.ID())
 				Expect(err).ToNot(HaveOccurred())
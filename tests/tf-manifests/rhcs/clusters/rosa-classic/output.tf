@@ -21,3 +21,7 @@ output "additional_infra_security_groups" {
 output "additional_control_plane_security_groups" {
   value = rhcs_cluster_rosa_classic.rosa_sts_cluster.aws_additional_control_plane_security_group_ids
 }
+
+output "state" {
+  value = rhcs_cluster_rosa_classic.rosa_sts_cluster.state
+}
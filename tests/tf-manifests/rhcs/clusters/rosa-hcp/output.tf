@@ -24,4 +24,8 @@ output "additional_compute_security_groups" {
 
 output "external_auth_providers_enabled" {
   value = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.external_auth_providers_enabled
-}
\ No newline at end of file
+}
+
+output "state" {
+  value = rhcs_cluster_rosa_hcp.rosa_hcp_cluster.state
+}
@@ -26,13 +26,22 @@ terraform {
 provider "rhcs" {
 }
 
+locals {
+  claims = var.claims != null ? var.claims : {
+    email              = var.claim_email
+    groups             = null
+    name               = var.claim_name
+    preferred_username = var.claim_preferred_username
+  }
+}
+
 resource "rhcs_identity_provider" "openid_idp" {
   cluster        = var.cluster_id
   name           = var.name
   mapping_method = var.mapping_method
   openid = {
     ca                         = var.ca
-    claims                     = var.claims
+    claims                     = local.claims
     client_id                  = var.client_id
     client_secret              = var.client_secret
     extra_scopes               = var.extra_scopes
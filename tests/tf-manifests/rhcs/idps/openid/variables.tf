@@ -18,6 +18,18 @@ variable "claims" {
   })
   default = null
 }
+variable "claim_email" {
+  type    = list(string)
+  default = null
+}
+variable "claim_name" {
+  type    = list(string)
+  default = null
+}
+variable "claim_preferred_username" {
+  type    = list(string)
+  default = null
+}
 variable "extra_scopes" {
   type    = list(string)
   default = null
@@ -8,5 +8,6 @@ output "machine_pools" {
     autoscaling_enabled : mp.autoscaling_enabled
     labels : mp.labels
     taints : mp.taints
+    tags : mp.aws_tags
   }]
 }
\ No newline at end of file
@@ -14,5 +14,8 @@ output "machine_pools" {
     tags : mp.aws_node_pool.tags
     disk_size : mp.aws_node_pool.disk_size
     image_type : mp.aws_node_pool.image_type
+    subnet_id : mp.subnet_id
+    auto_repair : mp.auto_repair
+    openshift_version : mp.version
   }]
 }
@@ -13,14 +13,54 @@ import (
 	. "github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/log"
 )
 
+// pollInterval is the time waited between two calls of the polling helpers
+var pollInterval = 10 * time.Second
+
+// retryLimit and retryBackoff configure the retries of the retrieve/list helpers
+// on transient OCM errors
+var (
+	retryLimit   = 3
+	retryBackoff = 2 * time.Second
+)
+
 // RetrieveClusterDetail will retrieve cluster detailed information based on the clusterID
 func RetrieveClusterDetail(connection *client.Connection, clusterID string) (*cmv1.ClusterGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Get().Send, retryLimit, retryBackoff)
+}
+
+// GetClusterVersion returns the version running in the cluster, the 'openshift_version' reported
+// by the cluster, or the 'version.raw_id' that it was requested with while it isn't reported yet
+func GetClusterVersion(connection *client.Connection, clusterID string) (string, error) {
+	resp, err := RetrieveClusterDetail(connection, clusterID)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve cluster %s: %v", clusterID, err)
+	}
+	if version := resp.Body().OpenshiftVersion(); version != "" {
+		return version, nil
+	}
+	if version := resp.Body().Version().RawID(); version != "" {
+		return version, nil
+	}
+	return "", fmt.Errorf("cluster %s doesn't report its version", clusterID)
+}
+
+// GetClusterProduct returns the product of the cluster, for example 'rosa' or 'osd', and whether
+// it is a hosted control plane cluster, that is, if hypershift is enabled
+func GetClusterProduct(connection *client.Connection, clusterID string) (product string, hcp bool, err error) {
+	resp, err := RetrieveClusterDetail(connection, clusterID)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to retrieve cluster %s: %v", clusterID, err)
+	}
+	product = resp.Body().Product().ID()
+	if product == "" {
+		return "", false, fmt.Errorf("cluster %s doesn't report its product", clusterID)
+	}
+	return product, resp.Body().Hypershift().Enabled(), nil
 }
 
 // RetrieveClusterIngress will retrieve default ingress detail information based on the clusterID
 func RetrieveClusterIngress(connection *client.Connection, clusterID string) (*cmv1.Ingress, error) {
-	ListResp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Ingresses().List().Send()
+	ListResp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Ingresses().List().Send, retryLimit, retryBackoff)
 	if err != nil {
 		return nil, err
 	}
@@ -36,56 +76,56 @@ func ListClusters(connection *client.Connection, parameters ...map[string]interf
 			request = request.Parameter(k, v)
 		}
 	}
-	response, err = request.Send()
+	response, err = doWithRetry(request.Send, retryLimit, retryBackoff)
 	return
 }
 
 // ListClusterResources will retrieve cluster detailed information about its resources based on the clusterID
 func ListClusterResources(connection *client.Connection, clusterID string) (*cmv1.ClusterResourcesGetResponse, error) {
 	request := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Resources().Live().Get()
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 // RetrieveClusterCredentials will return the response of cluster credentials
 func RetrieveClusterCredentials(connection *client.Connection, clusterID string) (*cmv1.CredentialsGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Credentials().Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Credentials().Get().Send, retryLimit, retryBackoff)
 }
 
 // ListClusterGroups will return cluster groups
 func ListClusterGroups(connection *client.Connection, clusterID string) (*cmv1.GroupsListResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Groups().List().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Groups().List().Send, retryLimit, retryBackoff)
 }
 
 // RetrieveClusterGroupDetail will return cluster specified group information
 func RetrieveClusterGroupDetail(connection *client.Connection, clusterID string, groupID string) (*cmv1.GroupGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Groups().Group(groupID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Groups().Group(groupID).Get().Send, retryLimit, retryBackoff)
 }
 
 func ListClusterGroupUsers(connection *client.Connection, clusterID string, groupID string) (*cmv1.UsersListResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Groups().Group(groupID).Users().List().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Groups().Group(groupID).Users().List().Send, retryLimit, retryBackoff)
 }
 
 func RetrieveClusterGroupUserDetail(connection *client.Connection, clusterID string, groupID string, userID string) (*cmv1.UserGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Groups().Group(groupID).Users().User(userID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Groups().Group(groupID).Users().User(userID).Get().Send, retryLimit, retryBackoff)
 }
 
 func ListClusterIDPs(connection *client.Connection, clusterID string) (*cmv1.IdentityProvidersListResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().List().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().List().Send, retryLimit, retryBackoff)
 }
 
 func RetrieveClusterIDPDetail(connection *client.Connection, clusterID string, IDPID string) (*cmv1.IdentityProviderGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().IdentityProvider(IDPID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().IdentityProvider(IDPID).Get().Send, retryLimit, retryBackoff)
 }
 
 func ListHtpasswdUsers(connection *client.Connection, clusterID string, IDPID string) (*cmv1.HTPasswdUsersListResponse, error) {
-	return connection.ClustersMgmt().V1().
+	request := connection.ClustersMgmt().V1().
 		Clusters().
 		Cluster(clusterID).
 		IdentityProviders().
 		IdentityProvider(IDPID).
 		HtpasswdUsers().
-		List().
-		Send()
+		List()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 // RetrieveClusterLogDetail return the log response based on parameter
@@ -97,7 +137,7 @@ func RetrieveClusterInstallLogDetail(connection *client.Connection, clusterID st
 			request = request.Parameter(paramK, paramV)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 // RetrieveClusterUninstallLogDetail return the uninstall log response based on parameter
@@ -109,11 +149,31 @@ func RetrieveClusterUninstallLogDetail(connection *client.Connection, clusterID
 			request = request.Parameter(paramK, paramV)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
+}
+
+// GetClusterInstallLogs returns the content of the install logs of the cluster, useful to dump
+// them when an e2e test fails
+func GetClusterInstallLogs(connection *client.Connection, clusterID string) (string, error) {
+	resp, err := RetrieveClusterInstallLogDetail(connection, clusterID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the install logs of cluster %s: %v", clusterID, err)
+	}
+	return resp.Body().Content(), nil
+}
+
+// GetClusterUninstallLogs returns the content of the uninstall logs of the cluster, useful to dump
+// them when an e2e test fails
+func GetClusterUninstallLogs(connection *client.Connection, clusterID string) (string, error) {
+	resp, err := RetrieveClusterUninstallLogDetail(connection, clusterID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the uninstall logs of cluster %s: %v", clusterID, err)
+	}
+	return resp.Body().Content(), nil
 }
 
 func RetrieveClusterStatus(connection *client.Connection, clusterID string) (*cmv1.ClusterStatusGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Status().Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Status().Get().Send, retryLimit, retryBackoff)
 }
 
 // cloud_providers & regions
@@ -125,11 +185,11 @@ func ListCloudProviders(connection *client.Connection, params ...map[string]inte
 			request = request.Parameter(k, v)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 func RetrieveCloudProviderDetail(connection *client.Connection, providerID string) (*cmv1.CloudProviderGetResponse, error) {
-	return connection.ClustersMgmt().V1().CloudProviders().CloudProvider(providerID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().CloudProviders().CloudProvider(providerID).Get().Send, retryLimit, retryBackoff)
 }
 
 // ListRegions list the regions of specified cloud providers
@@ -141,22 +201,46 @@ func ListRegions(connection *client.Connection, providerID string, params ...map
 			request = request.Parameter(k, v)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 func RetrieveRegionDetail(connection *client.Connection, providerID string, regionID string) (*cmv1.CloudRegionGetResponse, error) {
-	return connection.ClustersMgmt().V1().CloudProviders().CloudProvider(providerID).Regions().Region(regionID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().CloudProviders().CloudProvider(providerID).Regions().Region(regionID).Get().Send, retryLimit, retryBackoff)
+}
+
+// Features of the regions checked by RegionSupports
+const (
+	RegionFeatureMultiAZ    = "multi_az"
+	RegionFeatureHypershift = "hypershift"
+)
+
+// RegionSupports checks if the region of the cloud provider supports the given feature, one of
+// RegionFeatureMultiAZ or RegionFeatureHypershift
+func RegionSupports(connection *client.Connection, cloudProvider string, region string, feature string) (bool, error) {
+	resp, err := RetrieveRegionDetail(connection, cloudProvider, region)
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve region %s of cloud provider %s: %v", region, cloudProvider, err)
+	}
+	switch feature {
+	case RegionFeatureMultiAZ:
+		return resp.Body().SupportsMultiAZ(), nil
+	case RegionFeatureHypershift:
+		return resp.Body().SupportsHypershift(), nil
+	default:
+		return false, fmt.Errorf("unknown region feature '%s', it should be '%s' or '%s'",
+			feature, RegionFeatureMultiAZ, RegionFeatureHypershift)
+	}
 }
 
 func ListAvailableRegions(connection *client.Connection, providerID string, body *cmv1.AWS) (
 	*cmv1.AvailableRegionsSearchResponse, error) {
-	return connection.ClustersMgmt().
+	request := connection.ClustersMgmt().
 		V1().CloudProviders().
 		CloudProvider(providerID).
 		AvailableRegions().
 		Search().
-		Body(body).
-		Send()
+		Body(body)
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 // version
@@ -167,12 +251,12 @@ func ListVersions(connection *client.Connection, parameter ...map[string]interfa
 			request = request.Parameter(k, v)
 		}
 	}
-	resp, err = request.Send()
+	resp, err = doWithRetry(request.Send, retryLimit, retryBackoff)
 	return
 }
 
 func RetrieveVersionDetail(connection *client.Connection, versionID string) (*cmv1.VersionGetResponse, error) {
-	return connection.ClustersMgmt().V1().Versions().Version(versionID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Versions().Version(versionID).Get().Send, retryLimit, retryBackoff)
 }
 
 // ListMachineTypes will list the machine types
@@ -183,7 +267,7 @@ func ListMachineTypes(connection *client.Connection, params ...map[string]interf
 			request = request.Parameter(k, v)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 func DeleteMachinePool(connection *client.Connection, clusterID string, mpID string) (*cmv1.MachinePoolDeleteResponse, error) {
@@ -191,11 +275,11 @@ func DeleteMachinePool(connection *client.Connection, clusterID string, mpID str
 }
 
 func RetrieveIDP(connection *client.Connection, clusterID string, idpID string) (*cmv1.IdentityProviderGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().IdentityProvider(idpID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().IdentityProvider(idpID).Get().Send, retryLimit, retryBackoff)
 }
 
 func ListIDPs(connection *client.Connection, clusterID string) (*cmv1.IdentityProvidersListResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().List().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().List().Send, retryLimit, retryBackoff)
 }
 
 func DeleteIDP(connection *client.Connection, clusterID string, idpID string) (*cmv1.IdentityProviderDeleteResponse, error) {
@@ -210,24 +294,89 @@ func CreateClusterIDP(connection *client.Connection, clusterID string, body *cmv
 	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).IdentityProviders().Add().Body(body).Send()
 }
 
+// WaitForIDPGone polls the identity provider until OCM answers with a 404,
+// as the deletion on the server side can lag behind the terraform destroy
+func WaitForIDPGone(connection *client.Connection, clusterID string, idpID string, timeout time.Duration) error {
+	start := time.Now()
+	for time.Since(start) < timeout {
+		resp, err := RetrieveIDP(connection, clusterID, idpID)
+		if resp != nil && resp.Status() == CON.HTTPNotFound {
+			Logger.Infof("The idp %s of cluster %s is gone", idpID, clusterID)
+			return nil
+		}
+		if resp == nil || resp.Status() != CON.HTTPOK {
+			return fmt.Errorf("failed to retrieve the idp %s of cluster %s: %v", idpID, clusterID, err)
+		}
+		Logger.Infof("Waiting for the idp %s of cluster %s to be deleted", idpID, clusterID)
+		time.Sleep(pollInterval)
+	}
+	return fmt.Errorf("timeout after %s waiting for the idp %s of cluster %s to be deleted", timeout.String(), idpID, clusterID)
+}
+
+// WaitForHtpasswdUser polls the users of the htpasswd identity provider until the given user is
+// listed, as logging in right after the creation can race with the propagation of the user
+func WaitForHtpasswdUser(connection *client.Connection, clusterID string, idpID string, username string, timeout time.Duration) error {
+	start := time.Now()
+	for time.Since(start) < timeout {
+		resp, err := ListHtpasswdUsers(connection, clusterID, idpID)
+		if err != nil {
+			return fmt.Errorf("failed to list the users of the idp %s of cluster %s: %v", idpID, clusterID, err)
+		}
+		for _, user := range resp.Items().Slice() {
+			if user.Username() == username {
+				Logger.Infof("The user %s of the idp %s of cluster %s is ready", username, idpID, clusterID)
+				return nil
+			}
+		}
+		Logger.Infof("Waiting for the user %s of the idp %s of cluster %s", username, idpID, clusterID)
+		time.Sleep(pollInterval)
+	}
+	return fmt.Errorf("timeout after %s waiting for the user %s of the idp %s of cluster %s", timeout.String(), username, idpID, clusterID)
+}
+
+// clusterAdminIDPName is the name of the htpasswd identity provider where OCM creates the admin
+// user of the cluster
+const clusterAdminIDPName = "cluster-admin"
+
+// ClusterAdminExists checks if the cluster still has the admin user created by OCM, that is the
+// 'cluster-admin' htpasswd identity provider with at least one user. OCM doesn't report the
+// 'kubeadmin' user created by the installer, so this is the admin that can be checked
+func ClusterAdminExists(connection *client.Connection, clusterID string) (bool, error) {
+	idps, err := ListClusterIDPs(connection, clusterID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list the idps of cluster %s: %v", clusterID, err)
+	}
+	for _, idp := range idps.Items().Slice() {
+		if idp.Name() != clusterAdminIDPName || idp.Type() != cmv1.IdentityProviderTypeHtpasswd {
+			continue
+		}
+		users, err := ListHtpasswdUsers(connection, clusterID, idp.ID())
+		if err != nil {
+			return false, fmt.Errorf("failed to list the users of the idp %s of cluster %s: %v", idp.ID(), clusterID, err)
+		}
+		return users.Items().Len() > 0, nil
+	}
+	return false, nil
+}
+
 // RetrieveClusterCPUTotalByNodeRolesOS will return the physical cpu_total of the compute nodes of the cluster
 func RetrieveClusterCPUTotalByNodeRolesOS(connection *client.Connection, clusterID string) (*cmv1.CPUTotalByNodeRolesOSMetricQueryGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).MetricQueries().CPUTotalByNodeRolesOS().Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).MetricQueries().CPUTotalByNodeRolesOS().Get().Send, retryLimit, retryBackoff)
 }
 
 // RetrieveClusterSocketTotalByNodeRolesOS will return the physical socket_total of the compute nodes of the cluster
 func RetrieveClusterSocketTotalByNodeRolesOS(connection *client.Connection, clusterID string) (*cmv1.SocketTotalByNodeRolesOSMetricQueryGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).MetricQueries().SocketTotalByNodeRolesOS().Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).MetricQueries().SocketTotalByNodeRolesOS().Get().Send, retryLimit, retryBackoff)
 }
 
 func RetrieveDetailedIngressOfCluster(connection *client.Connection, clusterID string, ingressID string) (*cmv1.IngressGetResponse, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Ingresses().Ingress(ingressID).Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Ingresses().Ingress(ingressID).Get().Send, retryLimit, retryBackoff)
 	return resp, err
 }
 
 // Cluster labels
 func ListClusterExternalConfiguration(connection *client.Connection, clusterID string) (*cmv1.ExternalConfigurationGetResponse, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).ExternalConfiguration().Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).ExternalConfiguration().Get().Send, retryLimit, retryBackoff)
 	return resp, err
 }
 
@@ -238,11 +387,11 @@ func ListClusterLabels(connection *client.Connection, clusterID string, paramete
 			request = request.Parameter(k, v)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 func RetrieveDetailedLabelOfCluster(connection *client.Connection, clusterID string, labelID string) (*cmv1.LabelGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).ExternalConfiguration().Labels().Label(labelID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).ExternalConfiguration().Labels().Label(labelID).Get().Send, retryLimit, retryBackoff)
 }
 
 // Machine Pool related
@@ -253,22 +402,58 @@ func ListMachinePool(connection *client.Connection, clusterID string, params ...
 			request = request.Parameter(k, v)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 func RetrieveClusterMachinePool(connection *client.Connection, clusterID string, machinePoolID string) (*cmv1.MachinePool, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).MachinePools().MachinePool(machinePoolID).Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).MachinePools().MachinePool(machinePoolID).Get().Send, retryLimit, retryBackoff)
 	if err != nil {
 		return nil, err
 	}
 	return resp.Body(), nil
 }
 func RetrieveClusterNodePool(connection *client.Connection, clusterID string, machinePoolID string) (*cmv1.NodePool, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).NodePools().NodePool(machinePoolID).Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).NodePools().NodePool(machinePoolID).Get().Send, retryLimit, retryBackoff)
 	if err != nil {
 		return nil, err
 	}
 	return resp.Body(), nil
 }
+
+// WaitForMachinePoolReplicas polls the machine pool until its current replicas reach the target,
+// and returns the last seen count. The pools of HCP clusters report the nodes that are actually
+// running in their status, while OCM only reports the requested replicas of classic pools
+func WaitForMachinePoolReplicas(connection *client.Connection, clusterID string, mpID string, target int, timeout time.Duration) (int, error) {
+	cluster, err := RetrieveClusterDetail(connection, clusterID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve cluster %s: %v", clusterID, err)
+	}
+	hcp := cluster.Body().Hypershift().Enabled()
+	current := 0
+	start := time.Now()
+	for time.Since(start) < timeout {
+		if hcp {
+			nodePool, err := RetrieveClusterNodePool(connection, clusterID, mpID)
+			if err != nil {
+				return current, fmt.Errorf("failed to retrieve the machine pool %s of cluster %s: %v", mpID, clusterID, err)
+			}
+			current = nodePool.Status().CurrentReplicas()
+		} else {
+			machinePool, err := RetrieveClusterMachinePool(connection, clusterID, mpID)
+			if err != nil {
+				return current, fmt.Errorf("failed to retrieve the machine pool %s of cluster %s: %v", mpID, clusterID, err)
+			}
+			current = machinePool.Replicas()
+		}
+		if current == target {
+			Logger.Infof("The machine pool %s of cluster %s has %d replicas", mpID, clusterID, current)
+			return current, nil
+		}
+		Logger.Infof("Waiting for the machine pool %s of cluster %s to have %d replicas, current replicas are %d", mpID, clusterID, target, current)
+		time.Sleep(pollInterval)
+	}
+	return current, fmt.Errorf("timeout after %s waiting for the machine pool %s of cluster %s to have %d replicas, last seen replicas are %d", timeout.String(), mpID, clusterID, target, current)
+}
+
 func CreateClusterAutoscaler(connection *client.Connection, clusterID string, body *cmv1.ClusterAutoscaler) (*cmv1.AutoscalerPostResponse, error) {
 	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Autoscaler().Post().Request(body).Send()
 	return resp, err
@@ -285,7 +470,7 @@ func DeleteClusterAutoscaler(connection *client.Connection, clusterID string) (*
 }
 
 func RetrieveClusterAutoscaler(connection *client.Connection, clusterID string) (*cmv1.AutoscalerGetResponse, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Autoscaler().Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Autoscaler().Get().Send, retryLimit, retryBackoff)
 	return resp, err
 }
 
@@ -297,16 +482,39 @@ func ListUpgradePolicies(connection *client.Connection, clusterID string, params
 			request = request.Parameter(k, v)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 func GetUpgradePolicyState(connection *client.Connection, clusterID string, upgradepolicyID string) (*cmv1.UpgradePolicyStateGetResponse, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).UpgradePolicies().UpgradePolicy(upgradepolicyID).State().Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).UpgradePolicies().UpgradePolicy(upgradepolicyID).State().Get().Send, retryLimit, retryBackoff)
 	return resp, err
 }
 
+// WaitForUpgradeComplete polls the state of the upgrade policy until it is completed. It fails
+// right away if the upgrade fails or is cancelled, as it will never complete then
+func WaitForUpgradeComplete(connection *client.Connection, clusterID string, policyID string, timeout time.Duration) error {
+	start := time.Now()
+	for time.Since(start) < timeout {
+		resp, err := GetUpgradePolicyState(connection, clusterID, policyID)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve the state of the upgrade policy %s of cluster %s: %v", policyID, clusterID, err)
+		}
+		state := resp.Body().Value()
+		switch state {
+		case cmv1.UpgradePolicyStateValueCompleted:
+			Logger.Infof("The upgrade policy %s of cluster %s is completed", policyID, clusterID)
+			return nil
+		case cmv1.UpgradePolicyStateValueFailed, cmv1.UpgradePolicyStateValueCancelled:
+			return fmt.Errorf("the upgrade policy %s of cluster %s is %s: %s", policyID, clusterID, state, resp.Body().Description())
+		}
+		Logger.Infof("Waiting for the upgrade policy %s of cluster %s to complete, current state is %s", policyID, clusterID, state)
+		time.Sleep(pollInterval)
+	}
+	return fmt.Errorf("timeout after %s waiting for the upgrade policy %s of cluster %s to complete", timeout.String(), policyID, clusterID)
+}
+
 func RetrieveUpgradePolicies(connection *client.Connection, clusterID string, upgradepolicyID string) (*cmv1.UpgradePolicyGetResponse, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).UpgradePolicies().UpgradePolicy(upgradepolicyID).Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).UpgradePolicies().UpgradePolicy(upgradepolicyID).Get().Send, retryLimit, retryBackoff)
 	return resp, err
 }
 
@@ -318,11 +526,11 @@ func ListControlPlaneUpgradePolicies(connection *client.Connection, clusterID st
 			request = request.Parameter(k, v)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 func RetrieveControlPlaneUpgradePolicy(connection *client.Connection, clusterID string, upgradepolicyID string) (*cmv1.ControlPlaneUpgradePolicyGetResponse, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).ControlPlane().UpgradePolicies().ControlPlaneUpgradePolicy(upgradepolicyID).Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).ControlPlane().UpgradePolicies().ControlPlaneUpgradePolicy(upgradepolicyID).Get().Send, retryLimit, retryBackoff)
 	return resp, err
 }
 
@@ -334,11 +542,11 @@ func ListNodePoolUpgradePolicies(connection *client.Connection, clusterID string
 			request = request.Parameter(k, v)
 		}
 	}
-	return request.Send()
+	return doWithRetry(request.Send, retryLimit, retryBackoff)
 }
 
 func RetrieveNodePoolUpgradePolicy(connection *client.Connection, clusterID string, npID string, upgradepolicyID string) (*cmv1.NodePoolUpgradePolicyGetResponse, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).NodePools().NodePool(npID).UpgradePolicies().NodePoolUpgradePolicy(upgradepolicyID).Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).NodePools().NodePool(npID).UpgradePolicies().NodePoolUpgradePolicy(upgradepolicyID).Get().Send, retryLimit, retryBackoff)
 	return resp, err
 }
 
@@ -347,25 +555,43 @@ func RetrieveCurrentAccount(connection *client.Connection, params ...map[string]
 	if len(params) > 1 {
 		return nil, errors.New("only one parameter map is allowed")
 	}
-	resp, err = connection.AccountsMgmt().V1().CurrentAccount().Get().Send()
+	resp, err = doWithRetry(connection.AccountsMgmt().V1().CurrentAccount().Get().Send, retryLimit, retryBackoff)
 	return resp, err
 }
 
+// OrganizationHasCapability checks if the given capability, for example
+// 'capability.organization.hypershift', is enabled for the organization
+func OrganizationHasCapability(connection *client.Connection, orgID string, capability string) (bool, error) {
+	resp, err := doWithRetry(
+		connection.AccountsMgmt().V1().Organizations().Organization(orgID).Get().
+			Parameter("fetchCapabilities", true).Send,
+		retryLimit, retryBackoff)
+	if err != nil {
+		return false, err
+	}
+	for _, orgCapability := range resp.Body().Capabilities() {
+		if orgCapability.Name() == capability {
+			return orgCapability.Value() == "true", nil
+		}
+	}
+	return false, nil
+}
+
 // RetrieveKubeletConfig returns the kubeletconfig
 func RetrieveKubeletConfig(connection *client.Connection, clusterID string) (*cmv1.KubeletConfig, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfig().Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfig().Get().Send, retryLimit, retryBackoff)
 	return resp.Body(), err
 }
 
 // ListHCPKubeletConfig returns the kubeletconfig
 func ListHCPKubeletConfigs(connection *client.Connection, clusterID string) ([]*cmv1.KubeletConfig, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfigs().List().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfigs().List().Send, retryLimit, retryBackoff)
 	return resp.Items().Slice(), err
 }
 
 // RetrieveHCPKubeletConfig returns the kubeletconfig
 func RetrieveHCPKubeletConfig(connection *client.Connection, clusterID string, kubeConfigID string) (*cmv1.KubeletConfig, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfigs().KubeletConfig(kubeConfigID).Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfigs().KubeletConfig(kubeConfigID).Get().Send, retryLimit, retryBackoff)
 	return resp.Body(), err
 }
 
@@ -377,7 +603,7 @@ func RetrieveNodePool(connection *client.Connection, clusterID string, npID stri
 			request = request.Parameter(k, v)
 		}
 	}
-	resp, err := request.Send()
+	resp, err := doWithRetry(request.Send, retryLimit, retryBackoff)
 	return resp.Body(), err
 }
 
@@ -389,7 +615,7 @@ func ListNodePools(connection *client.Connection, clusterID string, parameter ..
 			request = request.Parameter(k, v)
 		}
 	}
-	resp, err := request.Send()
+	resp, err := doWithRetry(request.Send, retryLimit, retryBackoff)
 	return resp.Items().Slice(), err
 }
 
@@ -434,23 +660,23 @@ func WaitClusterDeleted(connection *client.Connection, clusterID string, timeout
 }
 
 func RetrieveTuningConfig(connection *client.Connection, clusterID string, tcName string) (*cmv1.TuningConfigGetResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).TuningConfigs().TuningConfig(tcName).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).TuningConfigs().TuningConfig(tcName).Get().Send, retryLimit, retryBackoff)
 }
 
 func ListTuningConfigs(connection *client.Connection, clusterID string) (*cmv1.TuningConfigsListResponse, error) {
-	return connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).TuningConfigs().List().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).TuningConfigs().List().Send, retryLimit, retryBackoff)
 }
 
 func ListRegistryAllowlists(connection *client.Connection) (*cmv1.RegistryAllowlistsListResponse, error) {
-	return connection.ClustersMgmt().V1().RegistryAllowlists().List().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().RegistryAllowlists().List().Send, retryLimit, retryBackoff)
 }
 
 func RetrieveRegistryAllowlist(connection *client.Connection, allowlistID string) (*cmv1.RegistryAllowlistGetResponse, error) {
-	return connection.ClustersMgmt().V1().RegistryAllowlists().RegistryAllowlist(allowlistID).Get().Send()
+	return doWithRetry(connection.ClustersMgmt().V1().RegistryAllowlists().RegistryAllowlist(allowlistID).Get().Send, retryLimit, retryBackoff)
 }
 
 func RetrieveClusterImageMirror(connection *client.Connection, clusterID string, imageMirrorID string) (*cmv1.ImageMirror, error) {
-	resp, err := connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).ImageMirrors().ImageMirror(imageMirrorID).Get().Send()
+	resp, err := doWithRetry(connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).ImageMirrors().ImageMirror(imageMirrorID).Get().Send, retryLimit, retryBackoff)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,37 @@
+package cms
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+)
+
+func TestCMS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CMS Suite")
+}
+
+var _ = BeforeEach(func() {
+	// Keep the polling and retrying helpers fast against the mocked server
+	pollInterval = 10 * time.Millisecond
+	retryBackoff = 10 * time.Millisecond
+})
+
+// newTestConnection creates a connection against the given mocked server. The retries
+// of the SDK are disabled so that the ones of the helpers are the only ones involved.
+func newTestConnection(server *ghttp.Server) *client.Connection {
+	connection, err := client.NewConnectionBuilder().
+		Logger(logger).
+		URL(server.URL()).
+		RetryLimit(0).
+		Tokens(MakeTokenString("Bearer", 10*time.Minute)).
+		Build()
+	Expect(err).ToNot(HaveOccurred())
+	return connection
+}
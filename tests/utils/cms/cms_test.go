@@ -0,0 +1,875 @@
+package cms
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+)
+
+var _ = Describe("CMS helpers", func() {
+	var (
+		server     *Server
+		connection *client.Connection
+	)
+
+	BeforeEach(func() {
+		server = MakeTCPServer()
+		connection = newTestConnection(server)
+	})
+
+	AfterEach(func() {
+		connection.Close()
+		server.Close()
+	})
+
+	Context("WaitForIDPGone", func() {
+		const idpPath = "/api/clusters_mgmt/v1/clusters/123/identity_providers/456"
+		const notFound = `{
+		  "kind": "Error",
+		  "id": "404",
+		  "href": "/api/clusters_mgmt/v1/errors/404",
+		  "code": "CLUSTERS-MGMT-404",
+		  "reason": "Identity provider ID '456' for cluster '123' not found"
+		}`
+
+		It("returns once the idp is not found anymore", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpPath),
+					RespondWithJSON(http.StatusOK, `{"kind": "IdentityProvider", "id": "456"}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpPath),
+					RespondWithJSON(http.StatusNotFound, notFound),
+				),
+			)
+
+			err := WaitForIDPGone(connection, "123", "456", time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("fails when the idp is still there after the timeout", func() {
+			server.RouteToHandler(http.MethodGet, idpPath,
+				RespondWithJSON(http.StatusOK, `{"kind": "IdentityProvider", "id": "456"}`),
+			)
+
+			err := WaitForIDPGone(connection, "123", "456", 100*time.Millisecond)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("timeout"))
+		})
+	})
+
+	Context("WaitForHtpasswdUser", func() {
+		const usersPath = "/api/clusters_mgmt/v1/clusters/123/identity_providers/456/htpasswd_users"
+		const noUsers = `{"kind": "HTPasswdUserList", "page": 1, "size": 0, "total": 0, "items": []}`
+		const users = `{
+		  "kind": "HTPasswdUserList",
+		  "page": 1,
+		  "size": 2,
+		  "total": 2,
+		  "items": [
+		    {"kind": "HTPasswdUser", "id": "a1", "username": "other-user"},
+		    {"kind": "HTPasswdUser", "id": "a2", "username": "my-user"}
+		  ]
+		}`
+
+		It("returns once the user is listed", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, usersPath),
+					RespondWithJSON(http.StatusOK, noUsers),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, usersPath),
+					RespondWithJSON(http.StatusOK, users),
+				),
+			)
+
+			err := WaitForHtpasswdUser(connection, "123", "456", "my-user", time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("fails when the user isn't listed after the timeout", func() {
+			server.RouteToHandler(http.MethodGet, usersPath,
+				RespondWithJSON(http.StatusOK, noUsers),
+			)
+
+			err := WaitForHtpasswdUser(connection, "123", "456", "my-user", 100*time.Millisecond)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("timeout"))
+		})
+	})
+
+	Context("WaitForUpgradeComplete", func() {
+		const statePath = "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state"
+
+		It("returns once the upgrade is completed", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, statePath),
+					RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "scheduled"}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, statePath),
+					RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "started"}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, statePath),
+					RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "completed"}`),
+				),
+			)
+
+			err := WaitForUpgradeComplete(connection, "123", "456", time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+
+		It("fails right away when the upgrade fails", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, statePath),
+					RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "started"}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, statePath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "UpgradePolicyState",
+					  "value": "failed",
+					  "description": "Upgrade failed"
+					}`),
+				),
+			)
+
+			err := WaitForUpgradeComplete(connection, "123", "456", time.Minute)
+			Expect(err).To(MatchError(ContainSubstring("is failed: Upgrade failed")))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("fails when the upgrade isn't completed after the timeout", func() {
+			server.RouteToHandler(http.MethodGet, statePath,
+				RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyState", "value": "started"}`),
+			)
+
+			err := WaitForUpgradeComplete(connection, "123", "456", 100*time.Millisecond)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("timeout"))
+		})
+	})
+
+	Context("OrganizationHasCapability", func() {
+		const orgPath = "/api/accounts_mgmt/v1/organizations/456"
+		const organization = `{
+		  "kind": "Organization",
+		  "id": "456",
+		  "capabilities": [
+		    {
+		      "kind": "Capability",
+		      "name": "capability.organization.hypershift",
+		      "value": "true",
+		      "inherited": false
+		    },
+		    {
+		      "kind": "Capability",
+		      "name": "capability.organization.bypass_cluster_limits",
+		      "value": "false",
+		      "inherited": true
+		    }
+		  ]
+		}`
+
+		It("returns true when the capability is enabled", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgPath, "fetchCapabilities=true"),
+					RespondWithJSON(http.StatusOK, organization),
+				),
+			)
+
+			enabled, err := OrganizationHasCapability(connection, "456", "capability.organization.hypershift")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+		})
+
+		It("returns false when the capability is disabled", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgPath, "fetchCapabilities=true"),
+					RespondWithJSON(http.StatusOK, organization),
+				),
+			)
+
+			enabled, err := OrganizationHasCapability(connection, "456", "capability.organization.bypass_cluster_limits")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeFalse())
+		})
+
+		It("returns false when the capability is absent", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgPath, "fetchCapabilities=true"),
+					RespondWithJSON(http.StatusOK, organization),
+				),
+			)
+
+			enabled, err := OrganizationHasCapability(connection, "456", "capability.organization.unknown")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeFalse())
+		})
+
+		It("fails when the organization can't be retrieved", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgPath),
+					RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "id": "404"}`),
+				),
+			)
+
+			_, err := OrganizationHasCapability(connection, "456", "capability.organization.hypershift")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ClusterAdminExists", func() {
+		const idpsPath = "/api/clusters_mgmt/v1/clusters/123/identity_providers"
+		const usersPath = "/api/clusters_mgmt/v1/clusters/123/identity_providers/456/htpasswd_users"
+		const idps = `{
+		  "kind": "IdentityProviderList",
+		  "page": 1,
+		  "size": 2,
+		  "total": 2,
+		  "items": [
+		    {"kind": "IdentityProvider", "id": "789", "name": "my-github", "type": "GithubIdentityProvider"},
+		    {"kind": "IdentityProvider", "id": "456", "name": "cluster-admin", "type": "HTPasswdIdentityProvider"}
+		  ]
+		}`
+
+		It("returns true when the admin user exists", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpsPath),
+					RespondWithJSON(http.StatusOK, idps),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, usersPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "HTPasswdUserList",
+					  "page": 1,
+					  "size": 1,
+					  "total": 1,
+					  "items": [
+					    {"kind": "HTPasswdUser", "id": "a1", "username": "rhcs-clusteradmin"}
+					  ]
+					}`),
+				),
+			)
+
+			exists, err := ClusterAdminExists(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("returns false when the admin idp was revoked", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpsPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "IdentityProviderList",
+					  "page": 1,
+					  "size": 1,
+					  "total": 1,
+					  "items": [
+					    {"kind": "IdentityProvider", "id": "789", "name": "my-github", "type": "GithubIdentityProvider"}
+					  ]
+					}`),
+				),
+			)
+
+			exists, err := ClusterAdminExists(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("returns false when the admin idp has no users", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpsPath),
+					RespondWithJSON(http.StatusOK, idps),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, usersPath),
+					RespondWithJSON(http.StatusOK, `{"kind": "HTPasswdUserList", "page": 1, "size": 0, "total": 0, "items": []}`),
+				),
+			)
+
+			exists, err := ClusterAdminExists(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("fails when the idps can't be listed", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpsPath),
+					RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "id": "404"}`),
+				),
+			)
+
+			_, err := ClusterAdminExists(connection, "123")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("doWithRetry", func() {
+		const clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+		const cluster = `{"kind": "Cluster", "id": "123"}`
+		const tooManyRequests = `{"kind": "Error", "id": "429", "reason": "Too many requests"}`
+
+		It("retries on 429 until the call succeeds", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusTooManyRequests, tooManyRequests),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusTooManyRequests, tooManyRequests),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, cluster),
+				),
+			)
+
+			resp, err := RetrieveClusterDetail(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Status()).To(Equal(http.StatusOK))
+			Expect(resp.Body().ID()).To(Equal("123"))
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+
+		It("honors the Retry-After header", func() {
+			// Allow a wait of one second:
+			previousBackoff := retryBackoff
+			retryBackoff = 50 * time.Millisecond
+			DeferCleanup(func() {
+				retryBackoff = previousBackoff
+			})
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWith(http.StatusServiceUnavailable, `{"kind": "Error", "id": "503"}`,
+						http.Header{
+							"Content-Type": []string{"application/json"},
+							"Retry-After":  []string{"1"},
+						}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, cluster),
+				),
+			)
+
+			start := time.Now()
+			resp, err := RetrieveClusterDetail(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Status()).To(Equal(http.StatusOK))
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		})
+
+		It("limits the wait of the Retry-After header", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWith(http.StatusServiceUnavailable, `{"kind": "Error", "id": "503"}`,
+						http.Header{
+							"Content-Type": []string{"application/json"},
+							"Retry-After":  []string{"3600"},
+						}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, cluster),
+				),
+			)
+
+			start := time.Now()
+			resp, err := RetrieveClusterDetail(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Status()).To(Equal(http.StatusOK))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+
+		It("gives up after the maximum number of retries", func() {
+			server.RouteToHandler(http.MethodGet, clusterPath,
+				RespondWithJSON(http.StatusInternalServerError, `{"kind": "Error", "id": "500"}`),
+			)
+
+			resp, err := RetrieveClusterDetail(connection, "123")
+			Expect(err).To(HaveOccurred())
+			Expect(resp.Status()).To(Equal(http.StatusInternalServerError))
+			Expect(server.ReceivedRequests()).To(HaveLen(retryLimit + 1))
+		})
+
+		It("doesn't retry on other errors", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "id": "404"}`),
+				),
+			)
+
+			resp, err := RetrieveClusterDetail(connection, "123")
+			Expect(err).To(HaveOccurred())
+			Expect(resp.Status()).To(Equal(http.StatusNotFound))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("Cluster logs", func() {
+		It("returns the content of the install logs", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/logs/install"),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Log",
+					  "id": "install",
+					  "content": "level=info msg=\"Creating infrastructure resources...\"\nlevel=info msg=\"Install complete!\""
+					}`),
+				),
+			)
+
+			logs, err := GetClusterInstallLogs(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(logs).To(Equal("level=info msg=\"Creating infrastructure resources...\"\n" +
+				"level=info msg=\"Install complete!\""))
+		})
+
+		It("returns the content of the uninstall logs", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/logs/uninstall"),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Log",
+					  "id": "uninstall",
+					  "content": "level=info msg=\"Uninstall complete!\""
+					}`),
+				),
+			)
+
+			logs, err := GetClusterUninstallLogs(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(logs).To(Equal("level=info msg=\"Uninstall complete!\""))
+		})
+
+		It("fails when the logs can't be retrieved", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/logs/install"),
+					RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "id": "404"}`),
+				),
+			)
+
+			_, err := GetClusterInstallLogs(connection, "123")
+			Expect(err).To(MatchError(ContainSubstring("failed to get the install logs of cluster 123")))
+		})
+	})
+
+	Context("GetClusterVersion", func() {
+		const clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+
+		It("returns the version running in the cluster", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Cluster",
+					  "id": "123",
+					  "openshift_version": "4.14.12",
+					  "version": {"kind": "Version", "id": "openshift-v4.14.10", "raw_id": "4.14.10"}
+					}`),
+				),
+			)
+
+			version, err := GetClusterVersion(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal("4.14.12"))
+		})
+
+		It("returns the requested version if the running one isn't reported", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Cluster",
+					  "id": "123",
+					  "version": {"kind": "Version", "id": "openshift-v4.14.10", "raw_id": "4.14.10"}
+					}`),
+				),
+			)
+
+			version, err := GetClusterVersion(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal("4.14.10"))
+		})
+
+		It("fails when the cluster doesn't report a version", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123"}`),
+				),
+			)
+
+			_, err := GetClusterVersion(connection, "123")
+			Expect(err).To(MatchError("cluster 123 doesn't report its version"))
+		})
+	})
+
+	Context("GetClusterProduct", func() {
+		const clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+
+		It("returns the product of a classic cluster", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Cluster",
+					  "id": "123",
+					  "product": {"kind": "ProductLink", "id": "rosa"}
+					}`),
+				),
+			)
+
+			product, hcp, err := GetClusterProduct(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product).To(Equal("rosa"))
+			Expect(hcp).To(BeFalse())
+		})
+
+		It("returns the product of a hosted control plane cluster", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Cluster",
+					  "id": "123",
+					  "product": {"kind": "ProductLink", "id": "rosa"},
+					  "hypershift": {"enabled": true}
+					}`),
+				),
+			)
+
+			product, hcp, err := GetClusterProduct(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product).To(Equal("rosa"))
+			Expect(hcp).To(BeTrue())
+		})
+
+		It("returns the product of an OSD cluster", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "Cluster",
+					  "id": "123",
+					  "product": {"kind": "ProductLink", "id": "osd"}
+					}`),
+				),
+			)
+
+			product, hcp, err := GetClusterProduct(connection, "123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product).To(Equal("osd"))
+			Expect(hcp).To(BeFalse())
+		})
+
+		It("fails when the cluster doesn't report a product", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123"}`),
+				),
+			)
+
+			_, _, err := GetClusterProduct(connection, "123")
+			Expect(err).To(MatchError("cluster 123 doesn't report its product"))
+		})
+	})
+
+	Context("WaitForMachinePoolReplicas", func() {
+		const clusterPath = "/api/clusters_mgmt/v1/clusters/123"
+		const nodePoolPath = "/api/clusters_mgmt/v1/clusters/123/node_pools/mp-1"
+		const hcpCluster = `{"kind": "Cluster", "id": "123", "hypershift": {"enabled": true}}`
+		nodePool := func(currentReplicas int) string {
+			return fmt.Sprintf(`{
+			  "kind": "NodePool",
+			  "id": "mp-1",
+			  "replicas": 3,
+			  "status": {"kind": "NodePoolStatus", "current_replicas": %d}
+			}`, currentReplicas)
+		}
+
+		It("returns once the current replicas reach the target", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, hcpCluster),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, nodePoolPath),
+					RespondWithJSON(http.StatusOK, nodePool(1)),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, nodePoolPath),
+					RespondWithJSON(http.StatusOK, nodePool(2)),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, nodePoolPath),
+					RespondWithJSON(http.StatusOK, nodePool(3)),
+				),
+			)
+
+			replicas, err := WaitForMachinePoolReplicas(connection, "123", "mp-1", 3, time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(replicas).To(Equal(3))
+			Expect(server.ReceivedRequests()).To(HaveLen(4))
+		})
+
+		It("returns the last seen replicas after the timeout", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, hcpCluster),
+				),
+			)
+			server.RouteToHandler(http.MethodGet, nodePoolPath,
+				RespondWithJSON(http.StatusOK, nodePool(2)),
+			)
+
+			replicas, err := WaitForMachinePoolReplicas(connection, "123", "mp-1", 3, 100*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("last seen replicas are 2")))
+			Expect(replicas).To(Equal(2))
+		})
+
+		It("uses the replicas of the machine pools of classic clusters", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterPath),
+					RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123"}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/mp-1"),
+					RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "id": "mp-1", "replicas": 3}`),
+				),
+			)
+
+			replicas, err := WaitForMachinePoolReplicas(connection, "123", "mp-1", 3, time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(replicas).To(Equal(3))
+		})
+	})
+
+	Context("ListAndFilter", func() {
+		type idp struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		}
+
+		const idpsPath = "/api/clusters_mgmt/v1/clusters/123/identity_providers"
+
+		It("returns the items accepted by the predicate", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpsPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "IdentityProviderList",
+					  "items": [
+					    {"id": "idp-1", "type": "HTPasswdIdentityProvider"},
+					    {"id": "idp-2", "type": "GithubIdentityProvider"},
+					    {"id": "idp-3", "type": "HTPasswdIdentityProvider"}
+					  ]
+					}`),
+				),
+			)
+
+			items, err := ListAndFilter(connection, idpsPath, func(item idp) bool {
+				return item.Type == "HTPasswdIdentityProvider"
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(items).To(Equal([]idp{
+				{ID: "idp-1", Type: "HTPasswdIdentityProvider"},
+				{ID: "idp-3", Type: "HTPasswdIdentityProvider"},
+			}))
+		})
+
+		It("lists all the pages", func() {
+			pageSize := listPageSize
+			listPageSize = 2
+			DeferCleanup(func() {
+				listPageSize = pageSize
+			})
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpsPath, "page=1&size=2"),
+					RespondWithJSON(http.StatusOK, `{
+					  "items": [
+					    {"id": "idp-1", "type": "GithubIdentityProvider"},
+					    {"id": "idp-2", "type": "GitlabIdentityProvider"}
+					  ]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpsPath, "page=2&size=2"),
+					RespondWithJSON(http.StatusOK, `{
+					  "items": [
+					    {"id": "idp-3", "type": "HTPasswdIdentityProvider"}
+					  ]
+					}`),
+				),
+			)
+
+			items, err := ListAndFilter[idp](connection, idpsPath, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(items).To(HaveLen(3))
+			Expect(items[2].ID).To(Equal("idp-3"))
+		})
+
+		It("fails when the list can't be retrieved", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, idpsPath),
+					RespondWithJSON(http.StatusNotFound, `{
+					  "kind": "Error",
+					  "reason": "Cluster '123' not found"
+					}`),
+				),
+			)
+
+			items, err := ListAndFilter[idp](connection, idpsPath, nil)
+			Expect(err).To(MatchError(ContainSubstring("status 404")))
+			Expect(items).To(BeNil())
+		})
+	})
+
+	Context("ObjectExists", func() {
+		const poolPath = "/api/clusters_mgmt/v1/clusters/123/machine_pools/mp-1"
+
+		It("returns true when the object exists", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, poolPath),
+					RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "id": "mp-1"}`),
+				),
+			)
+
+			exists, err := ObjectExists(connection, poolPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("returns false when the object is gone", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, poolPath),
+					RespondWithJSON(http.StatusNotFound, `{"kind": "Error", "reason": "Machine pool 'mp-1' not found"}`),
+				),
+			)
+
+			exists, err := ObjectExists(connection, poolPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("fails on other statuses", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, poolPath),
+					RespondWithJSON(http.StatusForbidden, `{"kind": "Error", "reason": "Forbidden"}`),
+				),
+			)
+
+			_, err := ObjectExists(connection, poolPath)
+			Expect(err).To(MatchError(ContainSubstring("status 403")))
+		})
+	})
+
+	Context("RegionSupports", func() {
+		const regionPath = "/api/clusters_mgmt/v1/cloud_providers/aws/regions/us-east-1"
+
+		It("returns true when the region supports multi AZ", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, regionPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "CloudRegion",
+					  "id": "us-east-1",
+					  "supports_multi_az": true,
+					  "supports_hypershift": true
+					}`),
+				),
+			)
+
+			supported, err := RegionSupports(connection, "aws", "us-east-1", RegionFeatureMultiAZ)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(supported).To(BeTrue())
+		})
+
+		It("returns false when the region doesn't support multi AZ", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, regionPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "CloudRegion",
+					  "id": "us-east-1",
+					  "supports_multi_az": false,
+					  "supports_hypershift": true
+					}`),
+				),
+			)
+
+			supported, err := RegionSupports(connection, "aws", "us-east-1", RegionFeatureMultiAZ)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(supported).To(BeFalse())
+		})
+
+		It("checks the hypershift support", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, regionPath),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "CloudRegion",
+					  "id": "us-east-1",
+					  "supports_multi_az": true
+					}`),
+				),
+			)
+
+			supported, err := RegionSupports(connection, "aws", "us-east-1", RegionFeatureHypershift)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(supported).To(BeFalse())
+		})
+
+		It("fails for unknown features", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, regionPath),
+					RespondWithJSON(http.StatusOK, `{"kind": "CloudRegion", "id": "us-east-1"}`),
+				),
+			)
+
+			_, err := RegionSupports(connection, "aws", "us-east-1", "gpu")
+			Expect(err).To(MatchError(ContainSubstring("unknown region feature 'gpu'")))
+		})
+	})
+})
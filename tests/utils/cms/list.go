@@ -0,0 +1,96 @@
+package cms
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+)
+
+// listPageSize is the number of items requested per page by ListAndFilter
+var listPageSize = 100
+
+// rawResponse adapts the untyped responses of the connection to doWithRetry
+type rawResponse struct {
+	*client.Response
+}
+
+func (r rawResponse) Status() int {
+	if r.Response == nil {
+		return 0
+	}
+	return r.Response.Status()
+}
+
+func (r rawResponse) Header() http.Header {
+	header := http.Header{}
+	if r.Response != nil {
+		if value := r.Response.Header("Retry-After"); value != "" {
+			header.Set("Retry-After", value)
+		}
+	}
+	return header
+}
+
+// ListAndFilter lists all the pages of the collection of the given path, for example
+// '/api/clusters_mgmt/v1/clusters/123/identity_providers', and returns the items accepted by the
+// predicate, or all the items if it is nil. The items are decoded with encoding/json, so T is
+// usually a small struct with the json tags of the fields that the predicate and the caller need
+func ListAndFilter[T any](connection *client.Connection, path string, pred func(T) bool) ([]T, error) {
+	var result []T
+	for page := 1; ; page++ {
+		resp, err := doWithRetry(func() (rawResponse, error) {
+			resp, err := connection.Get().
+				Path(path).
+				Parameter("page", page).
+				Parameter("size", listPageSize).
+				Send()
+			return rawResponse{resp}, err
+		}, retryLimit, retryBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %v", path, err)
+		}
+		if resp.Status() != http.StatusOK {
+			return nil, fmt.Errorf("failed to list %s: status %d: %s", path, resp.Status(), resp.String())
+		}
+		var list struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(resp.Bytes(), &list); err != nil {
+			return nil, fmt.Errorf("failed to decode the list %s: %v", path, err)
+		}
+		for _, raw := range list.Items {
+			var item T
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, fmt.Errorf("failed to decode an item of the list %s: %v", path, err)
+			}
+			if pred == nil || pred(item) {
+				result = append(result, item)
+			}
+		}
+		if len(list.Items) < listPageSize {
+			return result, nil
+		}
+	}
+}
+
+// ObjectExists checks if the object of the given path, for example
+// '/api/clusters_mgmt/v1/clusters/123/machine_pools/worker', exists in OCM
+func ObjectExists(connection *client.Connection, path string) (bool, error) {
+	resp, err := doWithRetry(func() (rawResponse, error) {
+		resp, err := connection.Get().Path(path).Send()
+		return rawResponse{resp}, err
+	}, retryLimit, retryBackoff)
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve %s: %v", path, err)
+	}
+	switch resp.Status() {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to retrieve %s: status %d: %s", path, resp.Status(), resp.String())
+	}
+}
@@ -0,0 +1,60 @@
+package cms
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	. "github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/log"
+)
+
+// response is the part of the OCM responses needed to decide if a call has to be retried
+type response interface {
+	Status() int
+	Header() http.Header
+}
+
+// maxRetryAfterFactor limits the wait requested by a Retry-After header to this number of
+// backoffs, so that a large value doesn't stall the tests
+const maxRetryAfterFactor = 30
+
+// doWithRetry calls fn and retries it up to maxRetries times while OCM answers with
+// a 429 or a 5xx status. The wait between two calls doubles from backoff, unless the
+// response has a Retry-After header, which is honored instead up to maxRetryAfterFactor
+// backoffs. These retries come on top of the ones of the retry transport of the SDK
+// connection, so a single call can send more than maxRetries+1 requests to OCM.
+func doWithRetry[T response](fn func() (T, error), maxRetries int, backoff time.Duration) (T, error) {
+	resp, err := fn()
+	for retry := 0; retry < maxRetries && isTransientStatus(resp.Status()); retry++ {
+		wait := backoff * time.Duration(1<<retry)
+		if retryAfter, ok := parseRetryAfter(resp.Header().Get("Retry-After")); ok {
+			wait = min(retryAfter, backoff*maxRetryAfterFactor)
+		}
+		Logger.Warnf("Got status %d from OCM, retrying in %s (%d/%d)", resp.Status(), wait.String(), retry+1, maxRetries)
+		time.Sleep(wait)
+		resp, err = fn()
+	}
+	return resp, err
+}
+
+func isTransientStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// parseRetryAfter reads a Retry-After header value, given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -57,6 +57,11 @@ var (
 	DefaultAccountRolesPrefix = "account-role-"
 )
 
+// Cloud providers
+const (
+	AWS = "aws"
+)
+
 var (
 	DefaultAWSRegion = "us-east-2"
 	DefaultRHCSURL   = "https://api.openshift.com"
@@ -71,8 +76,8 @@ const (
 
 // Machine pool
 const (
-	DefaultMachinePoolName = "worker"
-	DefaultNodePoolName    = "workers"
+	DefaultClassicMachinePoolName = "worker"
+	DefaultNodePoolName           = "workers"
 )
 
 // Ec2MetadataHttpTokens for hcp cluster
@@ -119,6 +124,15 @@ func (ct *ClusterType) String() string {
 	return ct.Name
 }
 
+// DefaultMachinePoolName returns the name of the machine pool created along with a cluster of the
+// given type. Multi AZ HCP clusters suffix it with the index of the pool, like `workers-0`.
+func DefaultMachinePoolName(clusterType string) string {
+	if FindClusterType(clusterType).HCP {
+		return DefaultNodePoolName
+	}
+	return DefaultClassicMachinePoolName
+}
+
 type IDPType string
 
 const (
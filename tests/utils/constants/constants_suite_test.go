@@ -0,0 +1,13 @@
+package constants
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConstants(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Constants Suite")
+}
@@ -0,0 +1,20 @@
+package constants
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DefaultMachinePoolName", func() {
+	It("returns worker for classic clusters", func() {
+		Expect(DefaultMachinePoolName(ROSA_CLASSIC.String())).To(Equal("worker"))
+	})
+
+	It("returns workers for hcp clusters", func() {
+		Expect(DefaultMachinePoolName(ROSA_HCP.String())).To(Equal("workers"))
+	})
+
+	It("panics for an unknown cluster type", func() {
+		Expect(func() { DefaultMachinePoolName("osd") }).To(Panic())
+	})
+})
@@ -1,6 +1,11 @@
 package exec
 
 import (
+	"fmt"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/cms"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/constants"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/exec/manifests"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/helper"
@@ -111,6 +116,23 @@ type ClusterOutput struct {
 	Properties                           map[string]string `json:"properties,omitempty"`
 	UserTags                             map[string]string `json:"tags,omitempty"`
 	ExternalAuthProvidersEnabled         *bool             `json:"external_auth_providers_enabled,omitempty"`
+	State                                string            `json:"state,omitempty"`
+	ProvisionErrorMessage                string            `json:"provision_error_message,omitempty"`
+	ProvisionErrorCode                   string            `json:"provision_error_code,omitempty"`
+}
+
+// LoadStatus fills the state and the provision error of the output from the cluster status,
+// as the provision error is not exposed by the terraform resources
+func (output *ClusterOutput) LoadStatus(connection *client.Connection) error {
+	resp, err := cms.RetrieveClusterStatus(connection, output.ClusterID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the status of cluster %s: %v", output.ClusterID, err)
+	}
+	status := resp.Body()
+	output.State = string(status.State())
+	output.ProvisionErrorMessage = status.ProvisionErrorMessage()
+	output.ProvisionErrorCode = status.ProvisionErrorCode()
+	return nil
 }
 
 type ClusterService interface {
@@ -145,10 +167,20 @@ func (svc *clusterService) Init() (err error) {
 }
 
 func (svc *clusterService) Plan(args *ClusterArgs) (string, error) {
+	if args.Tags != nil {
+		if err := ValidateAWSTags(*args.Tags); err != nil {
+			return "", err
+		}
+	}
 	return svc.tfExecutor.RunTerraformPlan(args)
 }
 
 func (svc *clusterService) Apply(args *ClusterArgs) (string, error) {
+	if args.Tags != nil {
+		if err := ValidateAWSTags(*args.Tags); err != nil {
+			return "", err
+		}
+	}
 	return svc.tfExecutor.RunTerraformApply(args)
 }
 
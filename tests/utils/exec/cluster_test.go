@@ -0,0 +1,71 @@
+package exec
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+)
+
+var _ = Describe("Cluster output", func() {
+	var (
+		server     *ghttp.Server
+		connection *client.Connection
+	)
+
+	BeforeEach(func() {
+		server = MakeTCPServer()
+		connection = newTestConnection(server)
+	})
+
+	AfterEach(func() {
+		Expect(connection.Close()).To(Succeed())
+		server.Close()
+	})
+
+	Context("LoadStatus", func() {
+		It("populates the provision error of an errored cluster", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/status"),
+					RespondWithJSON(http.StatusOK, `{
+					  "kind": "ClusterStatus",
+					  "id": "123",
+					  "state": "error",
+					  "description": "Cluster installation failed",
+					  "provision_error_code": "OCM3055",
+					  "provision_error_message": "Cluster installation failed: AWS quota exceeded"
+					}`),
+				),
+			)
+
+			output := &ClusterOutput{ClusterID: "123"}
+			Expect(output.LoadStatus(connection)).To(Succeed())
+			Expect(output.State).To(Equal("error"))
+			Expect(output.ProvisionErrorCode).To(Equal("OCM3055"))
+			Expect(output.ProvisionErrorMessage).To(Equal("Cluster installation failed: AWS quota exceeded"))
+		})
+
+		It("fails when the status can't be retrieved", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/status"),
+					RespondWithJSON(http.StatusNotFound, `{
+					  "kind": "Error",
+					  "id": "404",
+					  "reason": "Cluster '123' not found"
+					}`),
+				),
+			)
+
+			output := &ClusterOutput{ClusterID: "123"}
+			err := output.LoadStatus(connection)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to retrieve the status of cluster 123"))
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package exec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+)
+
+// Diagnostic is an error or warning reported by terraform or by the provider
+type Diagnostic struct {
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Detail   string `json:"detail"`
+	Address  string `json:"address,omitempty"`
+}
+
+// Message of the machine readable UI of terraform (`terraform apply -json`), only the fields
+// needed to extract the diagnostics are decoded
+type jsonLogMessage struct {
+	Type       string      `json:"type"`
+	Diagnostic *Diagnostic `json:"diagnostic,omitempty"`
+}
+
+// ExtractDiagnostics returns the diagnostics found in the JSON log of a terraform command run with
+// the `-json` flag, in the order they were emitted. Lines that aren't JSON messages are ignored.
+func ExtractDiagnostics(jsonLog []byte) []Diagnostic {
+	var diagnostics []Diagnostic
+	scanner := bufio.NewScanner(bytes.NewReader(jsonLog))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		message := jsonLogMessage{}
+		if err := json.Unmarshal(line, &message); err != nil {
+			continue
+		}
+		if message.Type == "diagnostic" && message.Diagnostic != nil {
+			diagnostics = append(diagnostics, *message.Diagnostic)
+		}
+	}
+	return diagnostics
+}
@@ -0,0 +1,45 @@
+package exec
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Diagnostics", func() {
+	// Sample of the machine readable UI of `terraform apply -json`
+	const jsonLog = `{"@level":"info","@message":"Terraform 1.5.7","@module":"terraform.ui","terraform":"1.5.7","type":"version","ui":"1.1"}
+{"@level":"warn","@message":"Warning: Attribute Deprecated","@module":"terraform.ui","diagnostic":{"severity":"warning","summary":"Attribute Deprecated","detail":"Use 'autoscaling' instead.","address":"rhcs_cluster_rosa_classic.rosa_sts_cluster","range":{"filename":"main.tf","start":{"line":12,"column":3,"byte":250},"end":{"line":12,"column":22,"byte":269}}},"type":"diagnostic"}
+{"@level":"info","@message":"rhcs_cluster_rosa_classic.rosa_sts_cluster: Plan to create","@module":"terraform.ui","change":{"action":"create"},"type":"planned_change"}
+
+not a json line
+{"@level":"error","@message":"Error: Can't build cluster","@module":"terraform.ui","diagnostic":{"severity":"error","summary":"Can't build cluster","detail":"Invalid AZ 'us-west-2z'"},"type":"diagnostic"}
+`
+
+	It("extracts the diagnostics in order", func() {
+		Expect(ExtractDiagnostics([]byte(jsonLog))).To(Equal([]Diagnostic{
+			{
+				Severity: "warning",
+				Summary:  "Attribute Deprecated",
+				Detail:   "Use 'autoscaling' instead.",
+				Address:  "rhcs_cluster_rosa_classic.rosa_sts_cluster",
+			},
+			{
+				Severity: "error",
+				Summary:  "Can't build cluster",
+				Detail:   "Invalid AZ 'us-west-2z'",
+			},
+		}))
+	})
+
+	It("can be used to find deprecation warnings", func() {
+		Expect(ExtractDiagnostics([]byte(jsonLog))).To(ContainElement(And(
+			HaveField("Severity", "warning"),
+			HaveField("Summary", ContainSubstring("Deprecated")),
+		)))
+	})
+
+	It("returns nothing when there are no diagnostics", func() {
+		Expect(ExtractDiagnostics([]byte(`{"@level":"info","type":"version"}`))).To(BeEmpty())
+		Expect(ExtractDiagnostics(nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,33 @@
+package exec
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+)
+
+func TestExec(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Exec Suite")
+}
+
+// newTestConnection creates a connection against the given mocked server
+func newTestConnection(server *ghttp.Server) *client.Connection {
+	logger, err := client.NewStdLoggerBuilder().
+		Streams(GinkgoWriter, GinkgoWriter).
+		Build()
+	Expect(err).ToNot(HaveOccurred())
+	connection, err := client.NewConnectionBuilder().
+		Logger(logger).
+		URL(server.URL()).
+		Tokens(MakeTokenString("Bearer", 10*time.Minute)).
+		Build()
+	Expect(err).ToNot(HaveOccurred())
+	return connection
+}
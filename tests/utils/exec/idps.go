@@ -1,6 +1,13 @@
 package exec
 
 import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
+
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/constants"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/exec/manifests"
 )
@@ -19,6 +26,13 @@ type IDPArgs struct {
 	MappingMethod  *string           `hcl:"mapping_method"`
 	HtpasswdUsers  *[]HTPasswordUser `hcl:"htpasswd_users"`
 	URL            *string           `hcl:"idp_url"`
+
+	// OpenID supported
+	Issuer                 *string   `hcl:"issuer"`
+	ExtraScopes            *[]string `hcl:"extra_scopes"`
+	ClaimEmail             *[]string `hcl:"claim_email"`
+	ClaimName              *[]string `hcl:"claim_name"`
+	ClaimPreferredUsername *[]string `hcl:"claim_preferred_username"`
 }
 
 type HTPasswordUser struct {
@@ -26,6 +40,45 @@ type HTPasswordUser struct {
 	Password *string `cty:"password"`
 }
 
+// GenerateHTPasswordUsers converts the given map of passwords indexed by user name to the list
+// of users expected by the htpasswd identity provider, sorted by user name so that the generated
+// variables are stable. The passwords are kept in plain text, as the provider hashes them.
+func GenerateHTPasswordUsers(users map[string]string) []HTPasswordUser {
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]HTPasswordUser, len(names))
+	for i, name := range names {
+		username := name
+		password := users[name]
+		result[i] = HTPasswordUser{
+			Username: &username,
+			Password: &password,
+		}
+	}
+	return result
+}
+
+// GenerateHTPasswdFileContent returns the content of an htpasswd file for the given users, with
+// one `username:hash` line per user. The passwords are hashed with bcrypt, the same algorithm
+// that the provider uses.
+func GenerateHTPasswdFileContent(users []HTPasswordUser) (string, error) {
+	var content strings.Builder
+	for _, user := range users {
+		if user.Username == nil || user.Password == nil {
+			return "", fmt.Errorf("htpasswd users must have a user name and a password")
+		}
+		hash, err := idputils.GenerateHTPasswdCompatibleHash(*user.Password)
+		if err != nil {
+			return "", fmt.Errorf("can't hash the password of htpasswd user '%s': %v", *user.Username, err)
+		}
+		fmt.Fprintf(&content, "%s:%s\n", *user.Username, hash)
+	}
+	return content.String(), nil
+}
+
 type LDAPAttributes struct {
 	Emails             *[]string `cty:"email"`
 	IDs                *[]string `cty:"id"`
@@ -42,39 +95,82 @@ type IDPOutput struct {
 
 type IDPService interface {
 	Init() error
+	Validate(args *IDPArgs) (string, error)
 	Plan(args *IDPArgs) (string, error)
 	Apply(args *IDPArgs) (string, error)
 	Output() (*IDPOutput, error)
 	Destroy() (string, error)
+	Import(clusterID string, idpID string) (string, error)
+	RemoveHtpasswdUser(username string) error
+	UpdateHtpasswdPassword(username string, newPassword string) error
 
 	GetStateResource(resourceType string, resoureName string) (interface{}, error)
 
 	ReadTFVars() (*IDPArgs, error)
+	WriteTFVars(args *IDPArgs) error
 	DeleteTFVars() error
 }
 
 type idpService struct {
 	tfExecutor TerraformExecutor
+	idpType    constants.IDPType
 }
 
 func NewIDPService(tfWorkspace string, clusterType constants.ClusterType, idpType constants.IDPType) (IDPService, error) {
 	svc := &idpService{
 		tfExecutor: NewTerraformExecutor(tfWorkspace, manifests.GetIDPManifestsDir(clusterType, idpType)),
+		idpType:    idpType,
 	}
 	err := svc.Init()
 	return svc, err
 }
 
+// hostedDomainRE matches DNS domains, two or more labels of letters, digits and hyphens that
+// don't start or end with a hyphen
+var hostedDomainRE = regexp.MustCompile(`^(?i)([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// ValidateHostedDomain checks that the hosted domain of a google idp is a valid DNS domain, so
+// that an invalid domain is reported before running terraform
+func ValidateHostedDomain(domain string) error {
+	if len(domain) > 253 || !hostedDomainRE.MatchString(domain) {
+		return fmt.Errorf("invalid hosted domain '%s': it should be a DNS domain like 'example.com'", domain)
+	}
+	return nil
+}
+
+// validateIDPArgs checks the args that can be validated without running terraform
+func validateIDPArgs(args *IDPArgs) error {
+	if args.HostedDomain != nil {
+		return ValidateHostedDomain(*args.HostedDomain)
+	}
+	return nil
+}
+
 func (svc *idpService) Init() (err error) {
 	_, err = svc.tfExecutor.RunTerraformInit()
 	return
 }
 
+// Validate checks the manifests and the given args without applying them: it runs a terraform
+// validate, then a plan so that the provider checks the values.
+func (svc *idpService) Validate(args *IDPArgs) (string, error) {
+	if err := validateIDPArgs(args); err != nil {
+		return "", err
+	}
+	return validateAndPlan(svc.tfExecutor, args)
+}
+
 func (svc *idpService) Plan(args *IDPArgs) (string, error) {
+	if err := validateIDPArgs(args); err != nil {
+		return "", err
+	}
 	return svc.tfExecutor.RunTerraformPlan(args)
 }
 
 func (svc *idpService) Apply(args *IDPArgs) (string, error) {
+	if err := validateIDPArgs(args); err != nil {
+		return "", err
+	}
 	return svc.tfExecutor.RunTerraformApply(args)
 }
 
@@ -91,6 +187,69 @@ func (svc *idpService) Destroy() (string, error) {
 	return svc.tfExecutor.RunTerraformDestroy()
 }
 
+// Import imports the existing identity provider into the resource of the manifests.
+// The variables of the manifests need to be recorded first with WriteTFVars.
+func (svc *idpService) Import(clusterID string, idpID string) (string, error) {
+	if svc.idpType == constants.IDPMulti {
+		return "", fmt.Errorf("import is not supported for the %s manifests", svc.idpType)
+	}
+	resource := fmt.Sprintf("rhcs_identity_provider.%s_idp", svc.idpType)
+	return svc.tfExecutor.RunTerraformImport(resource, fmt.Sprintf("%s,%s", clusterID, idpID))
+}
+
+// RemoveHtpasswdUser removes the given user from the htpasswd identity provider, keeping the
+// other users. The identity provider is updated in place rather than recreated.
+func (svc *idpService) RemoveHtpasswdUser(username string) error {
+	args, err := svc.ReadTFVars()
+	if err != nil {
+		return err
+	}
+	if args.HtpasswdUsers == nil {
+		return fmt.Errorf("no htpasswd users recorded for the identity provider")
+	}
+	var users []HTPasswordUser
+	for _, user := range *args.HtpasswdUsers {
+		if user.Username != nil && *user.Username == username {
+			continue
+		}
+		users = append(users, user)
+	}
+	if len(users) == len(*args.HtpasswdUsers) {
+		return fmt.Errorf("htpasswd user '%s' not found", username)
+	}
+	if len(users) == 0 {
+		return fmt.Errorf("can't remove htpasswd user '%s' as it is the last one", username)
+	}
+	args.HtpasswdUsers = &users
+	_, err = svc.Apply(args)
+	return err
+}
+
+// UpdateHtpasswdPassword changes the password of the given user of the htpasswd identity
+// provider, leaving the other users untouched.
+func (svc *idpService) UpdateHtpasswdPassword(username string, newPassword string) error {
+	args, err := svc.ReadTFVars()
+	if err != nil {
+		return err
+	}
+	if args.HtpasswdUsers == nil {
+		return fmt.Errorf("no htpasswd users recorded for the identity provider")
+	}
+	users := *args.HtpasswdUsers
+	found := false
+	for i, user := range users {
+		if user.Username != nil && *user.Username == username {
+			users[i].Password = &newPassword
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("htpasswd user '%s' not found", username)
+	}
+	_, err = svc.Apply(args)
+	return err
+}
+
 func (svc *idpService) GetStateResource(resourceType string, resoureName string) (interface{}, error) {
 	return svc.tfExecutor.GetStateResource(resourceType, resoureName)
 }
@@ -101,6 +260,10 @@ func (svc *idpService) ReadTFVars() (*IDPArgs, error) {
 	return args, err
 }
 
+func (svc *idpService) WriteTFVars(args *IDPArgs) error {
+	return svc.tfExecutor.WriteTerraformVars(args)
+}
+
 func (svc *idpService) DeleteTFVars() error {
 	return svc.tfExecutor.DeleteTerraformVars()
 }
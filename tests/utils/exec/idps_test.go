@@ -0,0 +1,239 @@
+package exec
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/helper"
+)
+
+// fakeIDPExecutor records the args applied on top of the recorded variables
+type fakeIDPExecutor struct {
+	TerraformExecutor
+	vars    *IDPArgs
+	applied *IDPArgs
+	planned *IDPArgs
+	planErr error
+}
+
+func (e *fakeIDPExecutor) RunTerraformValidate() (string, error) {
+	return "Success! The configuration is valid.", nil
+}
+
+func (e *fakeIDPExecutor) RunTerraformPlan(argObj interface{}) (string, error) {
+	e.planned = argObj.(*IDPArgs)
+	return "Plan: 1 to add, 0 to change, 0 to destroy.", e.planErr
+}
+
+func (e *fakeIDPExecutor) ReadTerraformVars(obj interface{}) error {
+	*obj.(*IDPArgs) = *e.vars
+	return nil
+}
+
+func (e *fakeIDPExecutor) RunTerraformApply(argObj interface{}) (string, error) {
+	e.applied = argObj.(*IDPArgs)
+	return "", nil
+}
+
+var _ = Describe("IDP service", func() {
+	Context("Google hosted domain", func() {
+		It("accepts DNS domains", func() {
+			Expect(ValidateHostedDomain("example.com")).To(Succeed())
+			Expect(ValidateHostedDomain("my-team.Example.co.uk")).To(Succeed())
+		})
+
+		It("rejects invalid domains", func() {
+			for _, domain := range []string{"examplecom", "-example.com", "example-.com", "exa mple.com", "example..com", ""} {
+				Expect(ValidateHostedDomain(domain)).To(
+					MatchError(ContainSubstring("invalid hosted domain '%s'", domain)),
+				)
+			}
+		})
+
+		It("rejects an invalid domain before applying", func() {
+			executor := &fakeIDPExecutor{}
+			svc := &idpService{tfExecutor: executor}
+			_, err := svc.Apply(&IDPArgs{
+				Name:         helper.StringPointer("my-google"),
+				HostedDomain: helper.StringPointer("examplecom"),
+			})
+			Expect(err).To(MatchError("invalid hosted domain 'examplecom': it should be a DNS domain like 'example.com'"))
+			Expect(executor.applied).To(BeNil())
+		})
+	})
+
+	Context("OpenID", func() {
+		It("sends the extra scopes and the CA", func() {
+			executor := &fakeIDPExecutor{}
+			svc := &idpService{tfExecutor: executor}
+			scopes := []string{"email", "profile"}
+			_, err := svc.Apply(&IDPArgs{
+				ClusterID:    helper.StringPointer("123"),
+				Name:         helper.StringPointer("my-openid"),
+				ClientID:     helper.StringPointer("my-client"),
+				ClientSecret: helper.StringPointer("my-secret"),
+				Issuer:       helper.StringPointer("https://sso.example.com"),
+				CA:           helper.StringPointer("-----BEGIN CERTIFICATE-----"),
+				ExtraScopes:  &scopes,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			// Check the variables that terraform receives:
+			tfvars := filepath.Join(GinkgoT().TempDir(), "terraform.tfvars")
+			Expect(WriteTFvarsFile(executor.applied, tfvars)).To(Succeed())
+			content, err := os.ReadFile(tfvars)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(MatchRegexp(`extra_scopes\s*=\s*\["email", "profile"\]`))
+			Expect(string(content)).To(MatchRegexp(`ca\s*=\s*"-----BEGIN CERTIFICATE-----"`))
+		})
+	})
+
+	Context("RemoveHtpasswdUser", func() {
+		var executor *fakeIDPExecutor
+		var svc *idpService
+
+		BeforeEach(func() {
+			executor = &fakeIDPExecutor{
+				vars: &IDPArgs{
+					Name: helper.StringPointer("my-htpasswd"),
+					HtpasswdUsers: &[]HTPasswordUser{
+						{Username: helper.StringPointer("user-1"), Password: helper.StringPointer("password-1")},
+						{Username: helper.StringPointer("user-2"), Password: helper.StringPointer("password-2")},
+					},
+				},
+			}
+			svc = &idpService{tfExecutor: executor}
+		})
+
+		It("keeps the other users", func() {
+			Expect(svc.RemoveHtpasswdUser("user-1")).To(Succeed())
+			Expect(executor.applied).ToNot(BeNil())
+			Expect(*executor.applied.Name).To(Equal("my-htpasswd"))
+			Expect(*executor.applied.HtpasswdUsers).To(HaveLen(1))
+			Expect(*(*executor.applied.HtpasswdUsers)[0].Username).To(Equal("user-2"))
+			Expect(*(*executor.applied.HtpasswdUsers)[0].Password).To(Equal("password-2"))
+		})
+
+		It("fails if the user doesn't exist", func() {
+			err := svc.RemoveHtpasswdUser("user-3")
+			Expect(err).To(MatchError("htpasswd user 'user-3' not found"))
+			Expect(executor.applied).To(BeNil())
+		})
+
+		It("fails if the user is the last one", func() {
+			Expect(svc.RemoveHtpasswdUser("user-1")).To(Succeed())
+			executor.vars = executor.applied
+			executor.applied = nil
+			err := svc.RemoveHtpasswdUser("user-2")
+			Expect(err).To(MatchError(ContainSubstring("it is the last one")))
+			Expect(executor.applied).To(BeNil())
+		})
+	})
+
+	Context("UpdateHtpasswdPassword", func() {
+		var executor *fakeIDPExecutor
+		var svc *idpService
+
+		BeforeEach(func() {
+			executor = &fakeIDPExecutor{
+				vars: &IDPArgs{
+					HtpasswdUsers: &[]HTPasswordUser{
+						{Username: helper.StringPointer("user-1"), Password: helper.StringPointer("password-1")},
+						{Username: helper.StringPointer("user-2"), Password: helper.StringPointer("password-2")},
+					},
+				},
+			}
+			svc = &idpService{tfExecutor: executor}
+		})
+
+		It("changes only the password of the user", func() {
+			Expect(svc.UpdateHtpasswdPassword("user-2", "new-password")).To(Succeed())
+			Expect(executor.applied).ToNot(BeNil())
+			users := *executor.applied.HtpasswdUsers
+			Expect(users).To(HaveLen(2))
+			Expect(*users[0].Password).To(Equal("password-1"))
+			Expect(*users[1].Username).To(Equal("user-2"))
+			Expect(*users[1].Password).To(Equal("new-password"))
+		})
+
+		It("fails if the user doesn't exist", func() {
+			err := svc.UpdateHtpasswdPassword("user-3", "new-password")
+			Expect(err).To(MatchError("htpasswd user 'user-3' not found"))
+			Expect(executor.applied).To(BeNil())
+		})
+	})
+
+	Context("Validate", func() {
+		It("validates and plans without applying", func() {
+			executor := &fakeIDPExecutor{}
+			svc := &idpService{tfExecutor: executor}
+			args := &IDPArgs{Name: helper.StringPointer("my-htpasswd")}
+			output, err := svc.Validate(args)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(ContainSubstring("The configuration is valid"))
+			Expect(output).To(ContainSubstring("Plan: 1 to add"))
+			Expect(executor.planned).To(BeIdenticalTo(args))
+			Expect(executor.applied).To(BeNil())
+		})
+
+		It("returns the diagnostics of the plan", func() {
+			executor := &fakeIDPExecutor{
+				planErr: errors.New("Error: Missing Configuration for Required Attribute"),
+			}
+			svc := &idpService{tfExecutor: executor}
+			_, err := svc.Validate(&IDPArgs{})
+			Expect(err).To(MatchError(ContainSubstring("Missing Configuration for Required Attribute")))
+			Expect(executor.applied).To(BeNil())
+		})
+	})
+
+	Context("GenerateHTPasswordUsers", func() {
+		It("generates the users sorted by user name", func() {
+			users := GenerateHTPasswordUsers(map[string]string{
+				"user-2": "password-2",
+				"user-1": "password-1",
+			})
+			Expect(users).To(HaveLen(2))
+			Expect(*users[0].Username).To(Equal("user-1"))
+			Expect(*users[0].Password).To(Equal("password-1"))
+			Expect(*users[1].Username).To(Equal("user-2"))
+			Expect(*users[1].Password).To(Equal("password-2"))
+		})
+
+		It("generates an empty list for no users", func() {
+			Expect(GenerateHTPasswordUsers(nil)).To(BeEmpty())
+		})
+	})
+
+	Context("GenerateHTPasswdFileContent", func() {
+		It("generates one line with a bcrypt hash per user", func() {
+			users := GenerateHTPasswordUsers(map[string]string{
+				"user-1": "password-1",
+				"user-2": "password-2",
+			})
+			content, err := GenerateHTPasswdFileContent(users)
+			Expect(err).ToNot(HaveOccurred())
+			lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+			Expect(lines).To(HaveLen(2))
+			for i, line := range lines {
+				username, hash, found := strings.Cut(line, ":")
+				Expect(found).To(BeTrue())
+				Expect(username).To(Equal(*users[i].Username))
+				Expect(hash).To(HavePrefix("$2a$"))
+				Expect(hash).ToNot(ContainSubstring(*users[i].Password))
+			}
+		})
+
+		It("fails if a user has no password", func() {
+			_, err := GenerateHTPasswdFileContent([]HTPasswordUser{
+				{Username: helper.StringPointer("user-1")},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
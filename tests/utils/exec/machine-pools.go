@@ -1,12 +1,23 @@
 package exec
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
 
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	client "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/cms"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/constants"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/exec/manifests"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/helper"
+	. "github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/log"
 )
 
 type MachinePoolArgs struct {
@@ -59,6 +70,34 @@ type MachinePoolOutput struct {
 	Tags                  map[string]string  `json:"tags,omitempty"`
 	DiskSize              int                `json:"disk_size,omitempty"`
 	ImageType             string             `json:"image_type,omitempty"`
+
+	// HCP supported
+	SubnetID         string `json:"subnet_id,omitempty"`
+	AutoRepair       bool   `json:"auto_repair,omitempty"`
+	OpenshiftVersion string `json:"openshift_version,omitempty"`
+}
+
+// DiffMachinePoolOutputs returns a human readable list of the fields that differ between the two
+// given machine pool outputs, one per line and in the order of the struct fields. Fields are named
+// after their JSON key. The result is empty when the outputs are equal.
+func DiffMachinePoolOutputs(a, b MachinePoolOutput) string {
+	var diffs []string
+	aValue := reflect.ValueOf(a)
+	bValue := reflect.ValueOf(b)
+	outputType := aValue.Type()
+	for i := 0; i < outputType.NumField(); i++ {
+		aField := aValue.Field(i).Interface()
+		bField := bValue.Field(i).Interface()
+		if reflect.DeepEqual(aField, bField) {
+			continue
+		}
+		name := strings.Split(outputType.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = outputType.Field(i).Name
+		}
+		diffs = append(diffs, fmt.Sprintf("%s: %v -> %v", name, aField, bField))
+	}
+	return strings.Join(diffs, "\n")
 }
 
 type MachinePoolTaint struct {
@@ -69,9 +108,13 @@ type MachinePoolTaint struct {
 
 type MachinePoolService interface {
 	Init() error
+	Validate(args *MachinePoolArgs) (string, error)
 	Plan(args *MachinePoolArgs) (string, error)
 	Apply(args *MachinePoolArgs) (string, error)
+	ApplyInline(hcl string, args *MachinePoolArgs) (string, error)
+	CreateMany(args []*MachinePoolArgs) error
 	Output() (*MachinePoolsOutput, error)
+	Outputs() ([]MachinePoolOutput, error)
 	Destroy() (string, error)
 	ShowState(resource string) (string, error)
 	RemoveState(resource string) (string, error)
@@ -80,12 +123,17 @@ type MachinePoolService interface {
 }
 
 type machinePoolService struct {
-	tfExecutor TerraformExecutor
+	tfExecutor   TerraformExecutor
+	tfWorkspace  string
+	manifestsDir string
 }
 
 func NewMachinePoolService(tfWorkspace string, clusterType constants.ClusterType) (MachinePoolService, error) {
+	manifestsDir := manifests.GetMachinePoolsManifestsDir(clusterType)
 	svc := &machinePoolService{
-		tfExecutor: NewTerraformExecutor(tfWorkspace, manifests.GetMachinePoolsManifestsDir(clusterType)),
+		tfExecutor:   NewTerraformExecutor(tfWorkspace, manifestsDir),
+		tfWorkspace:  tfWorkspace,
+		manifestsDir: manifestsDir,
 	}
 	err := svc.Init()
 	return svc, err
@@ -96,14 +144,103 @@ func (svc *machinePoolService) Init() (err error) {
 	return
 }
 
+// Validate checks the manifests and the given args without applying them: it runs a terraform
+// validate, then a plan so that the provider checks the values.
+func (svc *machinePoolService) Validate(args *MachinePoolArgs) (string, error) {
+	if args.Tags != nil {
+		if err := ValidateAWSTags(*args.Tags); err != nil {
+			return "", err
+		}
+	}
+	return validateAndPlan(svc.tfExecutor, args)
+}
+
 func (svc *machinePoolService) Plan(args *MachinePoolArgs) (string, error) {
+	if args.Tags != nil {
+		if err := ValidateAWSTags(*args.Tags); err != nil {
+			return "", err
+		}
+	}
 	return svc.tfExecutor.RunTerraformPlan(args)
 }
 
 func (svc *machinePoolService) Apply(args *MachinePoolArgs) (string, error) {
+	if args.Tags != nil {
+		if err := ValidateAWSTags(*args.Tags); err != nil {
+			return "", err
+		}
+	}
 	return svc.tfExecutor.RunTerraformApply(args)
 }
 
+// ApplyInline inits and applies the given HCL instead of the machine pool manifests. The HCL
+// doesn't need to declare the provider, and it can use the variables of the manifests, which
+// are set from the given args. The outputs of the manifests are copied too, so the HCL must
+// declare the pools that they use, for example `rhcs_machine_pool.mps`. The following calls of
+// the service, for example Destroy, use the inline configuration as well.
+func (svc *machinePoolService) ApplyInline(hcl string, args *MachinePoolArgs) (string, error) {
+	executor, err := newInlineTerraformExecutor(svc.tfWorkspace, svc.manifestsDir, hcl)
+	if err != nil {
+		return "", err
+	}
+	svc.tfExecutor = executor
+	err = svc.Init()
+	if err != nil {
+		return "", err
+	}
+	return svc.Apply(args)
+}
+
+// CreateMany applies the given machine pools at once. Each pool is an instance of the machine pool
+// manifests used as a module, so the args have the same meaning as for Apply, and the
+// `machine_pools` output lists the pools of all the modules. Like ApplyInline, the following calls
+// of the service use this configuration.
+func (svc *machinePoolService) CreateMany(args []*MachinePoolArgs) error {
+	if len(args) == 0 {
+		return errors.New("no machine pool to create")
+	}
+	hcl, err := svc.manyMachinePoolsConfig(args)
+	if err != nil {
+		return err
+	}
+	executor, err := newInlineTerraformExecutor(svc.tfWorkspace, "", hcl)
+	if err != nil {
+		return err
+	}
+	svc.tfExecutor = executor
+	err = svc.Init()
+	if err != nil {
+		return err
+	}
+	_, err = svc.tfExecutor.RunTerraformApply(&struct{}{})
+	return err
+}
+
+// manyMachinePoolsConfig renders the configuration used by CreateMany: one module per args, and an
+// output concatenating the machine pools of the modules.
+func (svc *machinePoolService) manyMachinePoolsConfig(args []*MachinePoolArgs) (string, error) {
+	source, err := filepath.Abs(svc.manifestsDir)
+	if err != nil {
+		return "", err
+	}
+	var buffer bytes.Buffer
+	var outputs []string
+	for i, poolArgs := range args {
+		if poolArgs.Tags != nil {
+			if err := ValidateAWSTags(*poolArgs.Tags); err != nil {
+				return "", err
+			}
+		}
+		name := fmt.Sprintf("machine_pool_%d", i)
+		inputs := hclwrite.NewEmptyFile()
+		gohcl.EncodeIntoBody(poolArgs, inputs.Body())
+		fmt.Fprintf(&buffer, "module %q {\nsource = %q\n%s}\n\n", name, source, inputs.Bytes())
+		outputs = append(outputs, fmt.Sprintf("module.%s.machine_pools", name))
+	}
+	fmt.Fprintf(&buffer, "output \"machine_pools\" {\nvalue = concat(%s)\n}\n", strings.Join(outputs, ", "))
+	return string(hclwrite.Format(buffer.Bytes())), nil
+}
+
 func (svc *machinePoolService) Output() (*MachinePoolsOutput, error) {
 	var output MachinePoolsOutput
 	err := svc.tfExecutor.RunTerraformOutputIntoObject(&output)
@@ -113,6 +250,16 @@ func (svc *machinePoolService) Output() (*MachinePoolsOutput, error) {
 	return &output, nil
 }
 
+// Outputs returns the outputs of all the machine pools of the configuration, for example the pools
+// created with CreateMany, in the order of their args.
+func (svc *machinePoolService) Outputs() ([]MachinePoolOutput, error) {
+	output, err := svc.Output()
+	if err != nil {
+		return nil, err
+	}
+	return output.MachinePools, nil
+}
+
 func (svc *machinePoolService) Destroy() (string, error) {
 	return svc.tfExecutor.RunTerraformDestroy()
 }
@@ -150,7 +297,7 @@ func BuildDefaultMachinePoolArgsFromClusterState(clusterResource interface{}) (M
 		replicas := helper.DigInt(helper.DigArray(clusterResource, "instances")[0], "attributes", "replicas")
 		machinePoolArgs.Replicas = &replicas
 	}
-	machinePoolArgs.Name = helper.StringPointer("worker")
+	machinePoolArgs.Name = helper.StringPointer(constants.DefaultMachinePoolName(constants.ROSA_CLASSIC.String()))
 	machinePoolArgs.MachineType = helper.StringPointer(helper.DigString(helper.DigArray(clusterResource, "instances")[0], "attributes", "compute_machine_type"))
 	labelsInterface := helper.DigObject(helper.DigArray(clusterResource, "instances")[0], "attributes", "default_mp_labels")
 	labels := make(map[string]string)
@@ -163,8 +310,8 @@ func BuildDefaultMachinePoolArgsFromClusterState(clusterResource interface{}) (M
 
 func BuildDefaultMachinePoolArgsFromDefaultMachinePoolState(defaultMachinePoolResource interface{}) (MachinePoolArgs, error) {
 	var machinePoolArgs MachinePoolArgs
-	if helper.DigString(defaultMachinePoolResource, "type") != "rhcs_machine_pool" && helper.DigString(defaultMachinePoolResource, "name") != "worker" {
-		return machinePoolArgs, fmt.Errorf("expected a default machinepool resource of type rhcs_machine_pool and named worker, got %s named %s", helper.DigString(defaultMachinePoolResource, "type"), helper.DigString(defaultMachinePoolResource, "name"))
+	if helper.DigString(defaultMachinePoolResource, "type") != "rhcs_machine_pool" && helper.DigString(defaultMachinePoolResource, "name") != constants.DefaultClassicMachinePoolName {
+		return machinePoolArgs, fmt.Errorf("expected a default machinepool resource of type rhcs_machine_pool and named %s, got %s named %s", constants.DefaultClassicMachinePoolName, helper.DigString(defaultMachinePoolResource, "type"), helper.DigString(defaultMachinePoolResource, "name"))
 	}
 	if helper.DigBool(helper.DigArray(defaultMachinePoolResource, "instances")[0], "attributes", "autoscaling_enabled") {
 		machinePoolArgs.AutoscalingEnabled = helper.BoolPointer(true)
@@ -176,7 +323,7 @@ func BuildDefaultMachinePoolArgsFromDefaultMachinePoolState(defaultMachinePoolRe
 		replicas := helper.DigInt(helper.DigArray(defaultMachinePoolResource, "instances")[0], "attributes", "replicas")
 		machinePoolArgs.Replicas = &replicas
 	}
-	machinePoolArgs.Name = helper.StringPointer("worker")
+	machinePoolArgs.Name = helper.StringPointer(constants.DefaultMachinePoolName(constants.ROSA_CLASSIC.String()))
 	machinePoolArgs.MachineType = helper.StringPointer(helper.DigString(helper.DigArray(defaultMachinePoolResource, "instances")[0], "attributes", "machine_type"))
 	labelsInterface := helper.DigObject(helper.DigArray(defaultMachinePoolResource, "instances")[0], "attributes", "labels")
 	labels := make(map[string]string)
@@ -270,3 +417,35 @@ func BuildMachinePoolArgsFromCSResponse(clusterID string, machinePool *cmv1.Mach
 	}
 	return &machinePoolArgs
 }
+
+// CleanupByLabel deletes the machine pools of the cluster that have the given label, for the
+// tests that mark the pools they create with a label instead of a name prefix. All the pools are
+// tried, and the pools that couldn't be deleted are reported in the returned error.
+func CleanupByLabel(connection *client.Connection, clusterID string, labelKey string, labelValue string) error {
+	type labeledMachinePool struct {
+		ID     string            `json:"id"`
+		Labels map[string]string `json:"labels"`
+	}
+	pools, err := cms.ListAndFilter(connection,
+		fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/machine_pools", clusterID),
+		func(mp labeledMachinePool) bool {
+			value, ok := mp.Labels[labelKey]
+			return ok && value == labelValue
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to list the machine pools of cluster %s: %v", clusterID, err)
+	}
+	var errs []string
+	for _, mp := range pools {
+		Logger.Infof("Deleting machine pool %s of cluster %s with label %s=%s", mp.ID, clusterID, labelKey, labelValue)
+		_, err = cms.DeleteMachinePool(connection, clusterID, mp.ID)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", mp.ID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to delete the machine pools of cluster %s: %s", clusterID, strings.Join(errs, "; "))
+	}
+	return nil
+}
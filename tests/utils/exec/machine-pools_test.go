@@ -0,0 +1,305 @@
+package exec
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/helper"
+)
+
+var _ = Describe("Machine pool outputs diff", func() {
+	output := func() MachinePoolOutput {
+		return MachinePoolOutput{
+			ID:          "mp-1",
+			Name:        "mp-1",
+			ClusterID:   "123",
+			Replicas:    2,
+			MachineType: "m5.xlarge",
+			Labels:      map[string]string{"l1": "v1"},
+			Taints: []MachinePoolTaint{
+				{Key: "t1", Value: "v1", ScheduleType: "NoSchedule"},
+			},
+		}
+	}
+
+	It("returns nothing when the outputs are equal", func() {
+		Expect(DiffMachinePoolOutputs(output(), output())).To(BeEmpty())
+	})
+
+	It("lists every changed field", func() {
+		updated := output()
+		updated.Replicas = 3
+		updated.Labels = map[string]string{"l2": "v2"}
+		updated.AutoRepair = true
+		Expect(DiffMachinePoolOutputs(output(), updated)).To(Equal(
+			"replicas: 2 -> 3\n" +
+				"labels: map[l1:v1] -> map[l2:v2]\n" +
+				"auto_repair: false -> true",
+		))
+	})
+})
+
+// fakeInlineExecutor records the directory and the calls of an inline configuration.
+type fakeInlineExecutor struct {
+	TerraformExecutor
+	dir     string
+	inits   int
+	applied interface{}
+	output  string
+}
+
+func (e *fakeInlineExecutor) RunTerraformInit() (string, error) {
+	e.inits++
+	return "", nil
+}
+
+func (e *fakeInlineExecutor) RunTerraformApply(argObj interface{}) (string, error) {
+	e.applied = argObj
+	return "applied", nil
+}
+
+func (e *fakeInlineExecutor) RunTerraformOutputIntoObject(obj any) error {
+	return json.Unmarshal([]byte(e.output), obj)
+}
+
+func (e *fakeInlineExecutor) RunTerraformDestroy() (string, error) {
+	return "destroyed", nil
+}
+
+var _ = Describe("Machine pool inline configuration", func() {
+	var (
+		manifestsDir string
+		executor     *fakeInlineExecutor
+		original     func(string, string) TerraformExecutor
+	)
+
+	BeforeEach(func() {
+		manifestsDir = GinkgoT().TempDir()
+		executor = nil
+		Expect(os.WriteFile(path.Join(manifestsDir, "variable.tf"), []byte(`variable "cluster" {}`), 0600)).
+			To(Succeed())
+		Expect(os.WriteFile(path.Join(manifestsDir, "output.tf"), []byte(`output "machine_pools" {}`), 0600)).
+			To(Succeed())
+		original = newTerraformExecutor
+		newTerraformExecutor = func(tfWorkspace string, dir string) TerraformExecutor {
+			executor = &fakeInlineExecutor{dir: dir}
+			return executor
+		}
+		DeferCleanup(func() {
+			newTerraformExecutor = original
+			if executor != nil {
+				os.RemoveAll(executor.dir)
+			}
+		})
+	})
+
+	It("applies a minimal inline machine pool config", func() {
+		hcl := `resource "rhcs_machine_pool" "mps" {
+  cluster      = var.cluster
+  name         = "inline"
+  machine_type = "m5.xlarge"
+  replicas     = 2
+}
+`
+		args := &MachinePoolArgs{Cluster: helper.StringPointer("123")}
+		svc := &machinePoolService{tfWorkspace: "ws", manifestsDir: manifestsDir}
+		output, err := svc.ApplyInline(hcl, args)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(Equal("applied"))
+		Expect(executor.inits).To(Equal(1))
+		Expect(executor.applied).To(BeIdenticalTo(args))
+		Expect(svc.tfExecutor.(*inlineTerraformExecutor).TerraformExecutor).To(BeIdenticalTo(executor))
+
+		main, err := os.ReadFile(path.Join(executor.dir, "main.tf"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(main)).To(Equal(hcl))
+		providers, err := os.ReadFile(path.Join(executor.dir, "providers.tf"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(providers)).To(ContainSubstring(`source  = "terraform.local/local/rhcs"`))
+		variables, err := os.ReadFile(path.Join(executor.dir, "variable.tf"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(variables)).To(Equal(`variable "cluster" {}`))
+		outputs, err := os.ReadFile(path.Join(executor.dir, "output.tf"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(outputs)).To(Equal(`output "machine_pools" {}`))
+	})
+
+	It("removes the inline configuration when it's destroyed", func() {
+		svc := &machinePoolService{tfWorkspace: "ws", manifestsDir: manifestsDir}
+		_, err := svc.ApplyInline(`resource "rhcs_machine_pool" "mps" {}`, &MachinePoolArgs{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(executor.dir).To(BeADirectory())
+
+		output, err := svc.Destroy()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(Equal("destroyed"))
+		Expect(executor.dir).ToNot(BeAnExistingFile())
+	})
+
+	It("creates several machine pools at once", func() {
+		var args []*MachinePoolArgs
+		for _, name := range []string{"mp-0", "mp-1", "mp-2"} {
+			args = append(args, &MachinePoolArgs{
+				Cluster:     helper.StringPointer("123"),
+				Name:        helper.StringPointer(name),
+				MachineType: helper.StringPointer("m5.xlarge"),
+				Replicas:    helper.IntPointer(2),
+			})
+		}
+		svc := &machinePoolService{tfWorkspace: "ws", manifestsDir: manifestsDir}
+		Expect(svc.CreateMany(args)).To(Succeed())
+		Expect(executor.inits).To(Equal(1))
+		Expect(svc.tfExecutor.(*inlineTerraformExecutor).TerraformExecutor).To(BeIdenticalTo(executor))
+
+		main, err := os.ReadFile(path.Join(executor.dir, "main.tf"))
+		Expect(err).ToNot(HaveOccurred())
+		for i, name := range []string{"mp-0", "mp-1", "mp-2"} {
+			Expect(string(main)).To(ContainSubstring(`module "machine_pool_%d" {`, i))
+			Expect(string(main)).To(MatchRegexp(`name\s+= "%s"`, name))
+		}
+		Expect(string(main)).To(MatchRegexp(`source\s+= "%s"`, manifestsDir))
+		Expect(string(main)).To(ContainSubstring(
+			"value = concat(module.machine_pool_0.machine_pools, module.machine_pool_1.machine_pools, " +
+				"module.machine_pool_2.machine_pools)",
+		))
+		_, err = os.Stat(path.Join(executor.dir, "variable.tf"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		executor.output = `{
+		  "machine_pools": [
+		    {"machine_pool_id": "mp-0", "name": "mp-0", "cluster_id": "123", "replicas": 2, "machine_type": "m5.xlarge"},
+		    {"machine_pool_id": "mp-1", "name": "mp-1", "cluster_id": "123", "replicas": 2, "machine_type": "m5.xlarge"},
+		    {"machine_pool_id": "mp-2", "name": "mp-2", "cluster_id": "123", "replicas": 2, "machine_type": "m5.xlarge"}
+		  ]
+		}`
+		outputs, err := svc.Outputs()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outputs).To(HaveLen(3))
+		for i, output := range outputs {
+			Expect(output.ID).To(Equal(*args[i].Name))
+			Expect(output.Name).To(Equal(*args[i].Name))
+			Expect(output.ClusterID).To(Equal("123"))
+			Expect(output.Replicas).To(Equal(2))
+			Expect(output.MachineType).To(Equal("m5.xlarge"))
+		}
+	})
+
+	It("fails when there is no machine pool to create", func() {
+		svc := &machinePoolService{tfWorkspace: "ws", manifestsDir: manifestsDir}
+		Expect(svc.CreateMany(nil)).To(MatchError("no machine pool to create"))
+		Expect(executor).To(BeNil())
+	})
+})
+
+var _ = Describe("Machine pool cleanup by label", func() {
+	var (
+		server     *ghttp.Server
+		connection *client.Connection
+	)
+
+	BeforeEach(func() {
+		server = MakeTCPServer()
+		connection = newTestConnection(server)
+	})
+
+	AfterEach(func() {
+		Expect(connection.Close()).To(Succeed())
+		server.Close()
+	})
+
+	It("deletes only the pools with the label", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "MachinePoolList",
+				  "page": 1,
+				  "size": 4,
+				  "total": 4,
+				  "items": [
+				    {
+				      "kind": "MachinePool",
+				      "id": "worker"
+				    },
+				    {
+				      "kind": "MachinePool",
+				      "id": "mp-1",
+				      "labels": {
+				        "test": "cleanup"
+				      }
+				    },
+				    {
+				      "kind": "MachinePool",
+				      "id": "mp-2",
+				      "labels": {
+				        "test": "other"
+				      }
+				    },
+				    {
+				      "kind": "MachinePool",
+				      "id": "mp-3",
+				      "labels": {
+				        "app": "db",
+				        "test": "cleanup"
+				      }
+				    }
+				  ]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123/machine_pools/mp-1"),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123/machine_pools/mp-3"),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+		)
+
+		Expect(CleanupByLabel(connection, "123", "test", "cleanup")).To(Succeed())
+		Expect(server.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("reports the pools that can't be deleted", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "MachinePoolList",
+				  "page": 1,
+				  "size": 1,
+				  "total": 1,
+				  "items": [
+				    {
+				      "kind": "MachinePool",
+				      "id": "mp-1",
+				      "labels": {
+				        "test": "cleanup"
+				      }
+				    }
+				  ]
+				}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123/machine_pools/mp-1"),
+				RespondWithJSON(http.StatusBadRequest, `{
+				  "kind": "Error",
+				  "id": "400",
+				  "reason": "Machine pool 'mp-1' can't be deleted"
+				}`),
+			),
+		)
+
+		err := CleanupByLabel(connection, "123", "test", "cleanup")
+		Expect(err).To(MatchError(ContainSubstring("failed to delete the machine pools of cluster 123: mp-1")))
+	})
+})
@@ -0,0 +1,107 @@
+package exec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/cms"
+	. "github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/log"
+)
+
+// orphanPaths returns the OCM path of the object backing a resource from its 'cluster' and 'id'
+// attributes, indexed by resource type. Resources of other types aren't checked for orphans.
+var orphanPaths = map[string]func(cluster string, id string) string{
+	"rhcs_cluster_rosa_classic": clusterObjectPath(""),
+	"rhcs_cluster_rosa_hcp":     clusterObjectPath(""),
+	"rhcs_machine_pool":         clusterObjectPath("machine_pools"),
+	"rhcs_hcp_machine_pool":     clusterObjectPath("node_pools"),
+	"rhcs_identity_provider":    clusterObjectPath("identity_providers"),
+	"rhcs_tuning_config":        clusterObjectPath("tuning_configs"),
+	"rhcs_dns_domain": func(_ string, id string) string {
+		return "/api/clusters_mgmt/v1/dns_domains/" + id
+	},
+	"rhcs_rosa_oidc_config": func(_ string, id string) string {
+		return "/api/clusters_mgmt/v1/oidc_configs/" + id
+	},
+}
+
+func clusterObjectPath(collection string) func(cluster string, id string) string {
+	return func(cluster string, id string) string {
+		if collection == "" {
+			return "/api/clusters_mgmt/v1/clusters/" + id
+		}
+		return fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/%s/%s", cluster, collection, id)
+	}
+}
+
+// tfState is the part of the Terraform state needed to find the objects backing the resources
+type tfState struct {
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   interface{} `json:"index_key"`
+			Attributes struct {
+				ID      string `json:"id"`
+				Cluster string `json:"cluster"`
+			} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// DetectOrphans cross-checks the resources of the Terraform state of the executor against OCM,
+// and returns the addresses of the resources whose backing object doesn't exist anymore, for
+// example after a failed destroy
+func DetectOrphans(tfExecutor TerraformExecutor, connection *client.Connection) ([]string, error) {
+	output, err := tfExecutor.RunTerraformState("pull")
+	if err != nil {
+		return nil, err
+	}
+	var state tfState
+	err = json.Unmarshal([]byte(output), &state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the terraform state: %v", err)
+	}
+	var orphans []string
+	for _, resource := range state.Resources {
+		objectPath, ok := orphanPaths[resource.Type]
+		if !ok || resource.Mode != "managed" {
+			continue
+		}
+		for _, instance := range resource.Instances {
+			if instance.Attributes.ID == "" {
+				continue
+			}
+			address := resourceAddress(resource.Module, resource.Type, resource.Name, instance.IndexKey)
+			exists, err := cms.ObjectExists(connection, objectPath(instance.Attributes.Cluster, instance.Attributes.ID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to check resource %s: %v", address, err)
+			}
+			if !exists {
+				Logger.Warnf("Resource %s of the terraform state doesn't exist in OCM anymore", address)
+				orphans = append(orphans, address)
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// resourceAddress returns the address of a resource instance, for example
+// 'module.cluster.rhcs_machine_pool.mp[0]'
+func resourceAddress(module string, resourceType string, name string, indexKey interface{}) string {
+	address := resourceType + "." + name
+	if module != "" {
+		address = module + "." + address
+	}
+	switch key := indexKey.(type) {
+	case string:
+		address += fmt.Sprintf("[%q]", key)
+	case float64:
+		address += fmt.Sprintf("[%d]", int(key))
+	}
+	return address
+}
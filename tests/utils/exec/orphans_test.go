@@ -0,0 +1,130 @@
+package exec
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	client "github.com/openshift-online/ocm-sdk-go"
+)
+
+// fakeStateExecutor returns the given state when it is pulled.
+type fakeStateExecutor struct {
+	TerraformExecutor
+	state string
+}
+
+func (e *fakeStateExecutor) RunTerraformState(subcommand string, options ...string) (string, error) {
+	Expect(subcommand).To(Equal("pull"))
+	return e.state, nil
+}
+
+var _ = Describe("Orphans detection", func() {
+	var (
+		server     *ghttp.Server
+		connection *client.Connection
+	)
+
+	BeforeEach(func() {
+		server = MakeTCPServer()
+		connection = newTestConnection(server)
+	})
+
+	AfterEach(func() {
+		connection.Close()
+		server.Close()
+	})
+
+	It("reports the resources whose object is gone", func() {
+		executor := &fakeStateExecutor{
+			state: `{
+			  "version": 4,
+			  "resources": [
+			    {
+			      "mode": "data",
+			      "type": "rhcs_cluster_rosa_classic",
+			      "name": "cluster",
+			      "instances": [{"attributes": {"id": "456"}}]
+			    },
+			    {
+			      "mode": "managed",
+			      "type": "rhcs_cluster_rosa_classic",
+			      "name": "rosa_sts_cluster",
+			      "instances": [{"attributes": {"id": "123"}}]
+			    },
+			    {
+			      "module": "module.pools",
+			      "mode": "managed",
+			      "type": "rhcs_machine_pool",
+			      "name": "mp",
+			      "instances": [
+			        {"index_key": 0, "attributes": {"id": "mp-1", "cluster": "123"}},
+			        {"index_key": 1, "attributes": {"id": "mp-2", "cluster": "123"}}
+			      ]
+			    },
+			    {
+			      "mode": "managed",
+			      "type": "rhcs_cluster_wait",
+			      "name": "waiter",
+			      "instances": [{"attributes": {"id": "123", "cluster": "123"}}]
+			    }
+			  ]
+			}`,
+		}
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{"kind": "Cluster", "id": "123"}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/mp-1"),
+				RespondWithJSON(http.StatusOK, `{"kind": "MachinePool", "id": "mp-1"}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/mp-2"),
+				RespondWithJSON(http.StatusNotFound, `{
+				  "kind": "Error",
+				  "id": "404",
+				  "reason": "Machine pool 'mp-2' not found"
+				}`),
+			),
+		)
+
+		orphans, err := DetectOrphans(executor, connection)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orphans).To(Equal([]string{"module.pools.rhcs_machine_pool.mp[1]"}))
+		Expect(server.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("fails if an object can't be checked", func() {
+		executor := &fakeStateExecutor{
+			state: `{
+			  "version": 4,
+			  "resources": [
+			    {
+			      "mode": "managed",
+			      "type": "rhcs_dns_domain",
+			      "name": "domain",
+			      "instances": [{"attributes": {"id": "my.domain.openshift.dev"}}]
+			    }
+			  ]
+			}`,
+		}
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/dns_domains/my.domain.openshift.dev"),
+				RespondWithJSON(http.StatusForbidden, `{
+				  "kind": "Error",
+				  "id": "403",
+				  "reason": "Forbidden"
+				}`),
+			),
+		)
+
+		_, err := DetectOrphans(executor, connection)
+		Expect(err).To(MatchError(ContainSubstring("failed to check resource rhcs_dns_domain.domain")))
+	})
+})
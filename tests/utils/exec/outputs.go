@@ -0,0 +1,86 @@
+package exec
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// AssertOutputsEqual reads all the terraform outputs of the manifests in the given directory and
+// checks that they are equal to the expected ones. The keys listed in ignoredKeys are ephemeral
+// values (like generated IDs) and are skipped at any depth of the outputs.
+func AssertOutputsEqual(dir string, expected map[string]interface{}, ignoredKeys ...string) error {
+	actual := map[string]interface{}{}
+	err := NewTerraformExecutor("", dir).RunTerraformOutputIntoObject(&actual)
+	if err != nil {
+		return err
+	}
+	return compareOutputs(actual, expected, ignoredKeys...)
+}
+
+func compareOutputs(actual, expected map[string]interface{}, ignoredKeys ...string) error {
+	// Expected values are normalized through JSON so that they can be compared with the values
+	// decoded from the terraform output (numbers as float64, structs as maps, etc):
+	normalized := map[string]interface{}{}
+	data, err := json.Marshal(expected)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(data, &normalized)
+	if err != nil {
+		return err
+	}
+
+	ignored := map[string]bool{}
+	for _, key := range ignoredKeys {
+		ignored[key] = true
+	}
+	actualOutputs := withoutKeys(actual, ignored).(map[string]interface{})
+	expectedOutputs := withoutKeys(normalized, ignored).(map[string]interface{})
+
+	var diffs []string
+	for key, expectedValue := range expectedOutputs {
+		actualValue, ok := actualOutputs[key]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("missing output %s", key))
+			continue
+		}
+		if !reflect.DeepEqual(actualValue, expectedValue) {
+			diffs = append(diffs, fmt.Sprintf("output %s is %v, expected %v", key, actualValue, expectedValue))
+		}
+	}
+	for key := range actualOutputs {
+		if _, ok := expectedOutputs[key]; !ok {
+			diffs = append(diffs, fmt.Sprintf("unexpected output %s", key))
+		}
+	}
+	if len(diffs) > 0 {
+		sort.Strings(diffs)
+		return fmt.Errorf("outputs are not equal: %s", strings.Join(diffs, ", "))
+	}
+	return nil
+}
+
+// withoutKeys returns a copy of the given decoded JSON value without the ignored keys.
+func withoutKeys(value interface{}, ignored map[string]bool) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		result := map[string]interface{}{}
+		for key, element := range typed {
+			if !ignored[key] {
+				result[key] = withoutKeys(element, ignored)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(typed))
+		for i, element := range typed {
+			result[i] = withoutKeys(element, ignored)
+		}
+		return result
+	default:
+		return value
+	}
+}
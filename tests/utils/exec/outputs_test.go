@@ -0,0 +1,80 @@
+package exec
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Outputs", func() {
+	// Outputs of the rosa-classic machine pools manifests as returned by RunTerraformOutput
+	const machinePoolOutputs = `{
+	  "machine_pools": [
+	    {
+	      "machine_pool_id": "mp-1",
+	      "name": "mp-1",
+	      "cluster_id": "2a3b4c",
+	      "replicas": 3,
+	      "machine_type": "m5.xlarge",
+	      "autoscaling_enabled": false,
+	      "labels": {
+	        "team": "qe"
+	      },
+	      "taints": null
+	    }
+	  ]
+	}`
+
+	var actual map[string]interface{}
+
+	BeforeEach(func() {
+		actual = map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(machinePoolOutputs), &actual)).To(Succeed())
+	})
+
+	It("matches the outputs of a machine pool", func() {
+		expected := map[string]interface{}{
+			"machine_pools": []MachinePoolOutput{
+				{
+					Name:        "mp-1",
+					Replicas:    3,
+					MachineType: "m5.xlarge",
+					Labels:      map[string]string{"team": "qe"},
+				},
+			},
+		}
+		// Empty fields are either omitted or not part of the rosa-classic output
+		Expect(compareOutputs(actual, expected,
+			"machine_pool_id", "cluster_id", "autoscaling_enabled", "taints",
+			"ec2_metadata_http_tokens", "kubelet_configs")).To(Succeed())
+	})
+
+	It("reports the outputs that differ", func() {
+		expected := map[string]interface{}{
+			"machine_pools": []map[string]interface{}{
+				{
+					"name":                "mp-1",
+					"replicas":            2,
+					"machine_type":        "m5.xlarge",
+					"autoscaling_enabled": false,
+					"labels":              map[string]string{"team": "qe"},
+					"taints":              nil,
+				},
+			},
+			"cluster_id": "2a3b4c",
+		}
+		err := compareOutputs(actual, expected, "machine_pool_id", "cluster_id")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("output machine_pools is"))
+		Expect(err.Error()).ToNot(ContainSubstring("cluster_id"))
+	})
+
+	It("reports missing and unexpected outputs", func() {
+		expected := map[string]interface{}{
+			"cluster_id": "2a3b4c",
+		}
+		err := compareOutputs(actual, expected)
+		Expect(err).To(MatchError("outputs are not equal: missing output cluster_id, unexpected output machine_pools"))
+	})
+})
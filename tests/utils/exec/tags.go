@@ -0,0 +1,36 @@
+package exec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Limits of the AWS tags, see
+// https://docs.aws.amazon.com/tag-editor/latest/userguide/tagging.html
+const (
+	awsTagKeyMaxLength   = 128
+	awsTagValueMaxLength = 256
+)
+
+// ValidateAWSTags checks that the given tags respect the AWS length limits, so that invalid tags
+// are reported before running terraform
+func ValidateAWSTags(tags map[string]string) error {
+	var errs []string
+	for key, value := range tags {
+		if key == "" {
+			errs = append(errs, "tag key can't be empty")
+		}
+		if len(key) > awsTagKeyMaxLength {
+			errs = append(errs, fmt.Sprintf("tag key '%s' is longer than %d characters", key, awsTagKeyMaxLength))
+		}
+		if len(value) > awsTagValueMaxLength {
+			errs = append(errs, fmt.Sprintf("value of tag '%s' is longer than %d characters", key, awsTagValueMaxLength))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("invalid AWS tags: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
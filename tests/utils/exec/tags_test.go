@@ -0,0 +1,45 @@
+package exec
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AWS tags", func() {
+	It("accepts tags within the limits", func() {
+		Expect(ValidateAWSTags(map[string]string{
+			strings.Repeat("k", awsTagKeyMaxLength): strings.Repeat("v", awsTagValueMaxLength),
+			"empty-value":                           "",
+		})).To(Succeed())
+	})
+
+	It("accepts no tags", func() {
+		Expect(ValidateAWSTags(nil)).To(Succeed())
+	})
+
+	It("rejects an over-long tag key", func() {
+		key := strings.Repeat("k", awsTagKeyMaxLength+1)
+		err := ValidateAWSTags(map[string]string{key: "value"})
+		Expect(err).To(MatchError(ContainSubstring("tag key '%s' is longer than 128 characters", key)))
+	})
+
+	It("rejects an over-long tag value", func() {
+		err := ValidateAWSTags(map[string]string{"key": strings.Repeat("v", awsTagValueMaxLength+1)})
+		Expect(err).To(MatchError("invalid AWS tags: value of tag 'key' is longer than 256 characters"))
+	})
+
+	It("rejects an empty tag key", func() {
+		err := ValidateAWSTags(map[string]string{"": "value"})
+		Expect(err).To(MatchError("invalid AWS tags: tag key can't be empty"))
+	})
+
+	It("rejects the args of an over-long tag before running terraform", func() {
+		svc := &machinePoolService{}
+		_, err := svc.Apply(&MachinePoolArgs{
+			Tags: &map[string]string{"key": strings.Repeat("v", awsTagValueMaxLength+1)},
+		})
+		Expect(err).To(MatchError(ContainSubstring("invalid AWS tags")))
+	})
+})
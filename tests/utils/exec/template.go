@@ -0,0 +1,24 @@
+package exec
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// RenderTemplate renders the given Go template of a Terraform configuration with the given data,
+// like the templated HCL used by the provider tests, for example `url = "{{ .URL }}"`. Keys that
+// are missing from a map data object are reported as errors instead of rendering `<no value>`,
+// so that typos in the placeholders don't generate a valid but wrong configuration.
+func RenderTemplate(tmpl string, data any) (string, error) {
+	parsed, err := template.New("").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("can't parse template: %v", err)
+	}
+	var buffer bytes.Buffer
+	err = parsed.Execute(&buffer, data)
+	if err != nil {
+		return "", fmt.Errorf("can't render template: %v", err)
+	}
+	return buffer.String(), nil
+}
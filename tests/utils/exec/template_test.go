@@ -0,0 +1,56 @@
+package exec
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Terraform config templates", func() {
+	const providerTemplate = `provider "rhcs" {
+  url         = "{{ .URL }}"
+  {{ if .Token }}
+  token       = "{{ .Token }}"
+  {{ end }}
+  trusted_cas = file("{{ .CA }}")
+}`
+
+	It("renders the URL, token and CA placeholders of a struct", func() {
+		result, err := RenderTemplate(providerTemplate, struct {
+			URL   string
+			Token string
+			CA    string
+		}{
+			URL:   "https://localhost:8000",
+			Token: "my-token",
+			CA:    "/tmp/ca.pem",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(ContainSubstring(`url         = "https://localhost:8000"`))
+		Expect(result).To(ContainSubstring(`token       = "my-token"`))
+		Expect(result).To(ContainSubstring(`trusted_cas = file("/tmp/ca.pem")`))
+	})
+
+	It("renders the placeholders of a map", func() {
+		result, err := RenderTemplate(providerTemplate, map[string]any{
+			"URL":   "https://localhost:8000",
+			"Token": "",
+			"CA":    "/tmp/ca.pem",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(ContainSubstring(`url         = "https://localhost:8000"`))
+		Expect(result).ToNot(ContainSubstring("token"))
+	})
+
+	It("fails if a key of a map is missing", func() {
+		_, err := RenderTemplate(providerTemplate, map[string]any{
+			"URL":   "https://localhost:8000",
+			"Token": "my-token",
+		})
+		Expect(err).To(MatchError(ContainSubstring("can't render template")))
+	})
+
+	It("fails if the template is invalid", func() {
+		_, err := RenderTemplate(`url = "{{ .URL "`, nil)
+		Expect(err).To(MatchError(ContainSubstring("can't parse template")))
+	})
+})
@@ -22,6 +22,7 @@ const tfVarsFilenameTemplate = "terraform.%s.tfvars"
 
 type TerraformExecutor interface {
 	RunTerraformInit() (string, error)
+	RunTerraformValidate() (string, error)
 	RunTerraformPlan(argObj interface{}) (string, error)
 	RunTerraformApply(argObj interface{}) (string, error)
 	RunTerraformDestroy() (string, error)
@@ -48,6 +49,82 @@ func NewTerraformExecutor(tfWorkspace string, manifestsDir string) TerraformExec
 	}
 }
 
+// newTerraformExecutor creates the executors of the inline configurations. It is a variable so
+// that tests can replace it with a fake executor.
+var newTerraformExecutor = NewTerraformExecutor
+
+// inlineProvidersConfig is added to the inline configurations so that, like the manifests, they
+// use the locally built provider without having to declare it.
+const inlineProvidersConfig = `terraform {
+  required_providers {
+    rhcs = {
+      version = ">= 1.1.0"
+      source  = "terraform.local/local/rhcs"
+    }
+  }
+}
+
+provider "rhcs" {
+}
+`
+
+// inlineTerraformExecutor runs an inline configuration, and removes its temporary dir once the
+// configuration is destroyed.
+type inlineTerraformExecutor struct {
+	TerraformExecutor
+	dir string
+}
+
+func (e *inlineTerraformExecutor) RunTerraformDestroy() (string, error) {
+	output, err := e.TerraformExecutor.RunTerraformDestroy()
+	if err != nil {
+		return output, err
+	}
+	Logger.Infof("Removing inline configuration dir %s", e.dir)
+	return output, os.RemoveAll(e.dir)
+}
+
+// newInlineTerraformExecutor writes the given HCL to a new temporary directory and returns an
+// executor for it. The variables and outputs of the given manifests dir are copied along, so that
+// the HCL can use the same args and outputs as the manifests. Nothing is copied when the manifests
+// dir is empty. The directory is removed when the configuration is destroyed.
+func newInlineTerraformExecutor(tfWorkspace string, manifestsDir string, hcl string) (executor TerraformExecutor, err error) {
+	dir, err := os.MkdirTemp("", "rhcs-inline-*.d")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+	files := map[string]string{
+		"main.tf":      hcl,
+		"providers.tf": inlineProvidersConfig,
+	}
+	if manifestsDir != "" {
+		for _, name := range []string{"variable.tf", "output.tf"} {
+			content, err := os.ReadFile(path.Join(manifestsDir, name))
+			if err == nil {
+				files[name] = string(content)
+			} else if !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+	for name, content := range files {
+		err = os.WriteFile(path.Join(dir, name), []byte(content), 0600)
+		if err != nil {
+			return nil, err
+		}
+	}
+	Logger.Infof("Wrote inline configuration to dir %s", dir)
+	return &inlineTerraformExecutor{
+		TerraformExecutor: newTerraformExecutor(tfWorkspace, dir),
+		dir:               dir,
+	}, nil
+}
+
 // ************************ TF CMD***********************************
 func (ctx *terraformExecutorContext) runTerraformCommand(tfCmd string, cmdFlags ...string) (string, error) {
 	Logger.Infof("Running terraform %s in workspace %s and against the dir %s", tfCmd, ctx.tfWorkspace, ctx.manifestsDir)
@@ -86,6 +163,10 @@ func (ctx *terraformExecutorContext) RunTerraformInit() (string, error) {
 	return ctx.runTerraformCommand("init", "-no-color")
 }
 
+func (ctx *terraformExecutorContext) RunTerraformValidate() (string, error) {
+	return ctx.runTerraformCommand("validate", "-no-color")
+}
+
 func (ctx *terraformExecutorContext) RunTerraformPlan(argObj interface{}) (output string, err error) {
 	tempFile, err := ctx.writeTemporaryTFVarsFile(argObj)
 	if err != nil {
@@ -114,6 +195,17 @@ func (ctx *terraformExecutorContext) RunTerraformApply(argObj interface{}) (stri
 	return output, err
 }
 
+// validateAndPlan runs a terraform validate and then a plan with the given args, returning the
+// output of both. Nothing is applied.
+func validateAndPlan(executor TerraformExecutor, argObj interface{}) (string, error) {
+	validateOutput, err := executor.RunTerraformValidate()
+	if err != nil {
+		return validateOutput, err
+	}
+	planOutput, err := executor.RunTerraformPlan(argObj)
+	return validateOutput + "\n" + planOutput, err
+}
+
 func (ctx *terraformExecutorContext) RunTerraformDestroy() (output string, err error) {
 	varsFile := ctx.grantTFvarsFile()
 	if fileExists, err := helper.IsFileExists(varsFile); err != nil {
@@ -169,6 +261,13 @@ func (ctx *terraformExecutorContext) RunTerraformState(subcommand string, option
 }
 
 func (ctx *terraformExecutorContext) RunTerraformImport(importArgs ...string) (output string, err error) {
+	// Use the recorded tfvars when there are some, as the manifests variables are needed to import
+	varsFile := ctx.grantTFvarsFile()
+	if fileExists, err := helper.IsFileExists(varsFile); err != nil {
+		return "", err
+	} else if fileExists {
+		importArgs = append([]string{"-var-file", varsFile}, importArgs...)
+	}
 	return ctx.runTerraformCommand("import", importArgs...)
 }
 
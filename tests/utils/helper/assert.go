@@ -2,6 +2,8 @@ package helper
 
 import (
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -51,3 +53,54 @@ func AssertWaitPollWithErr(e error, msg string) {
 func ExpectTFErrorContains(err error, substring string) {
 	Expect(GetTFErrorMessage(err)).To(ContainSubstring(substring))
 }
+
+// AssertStateMatchesOCM checks that the attributes of a resource taken from the Terraform state
+// have the same values as the fields of the corresponding OCM object. The keys of the mapping
+// are the paths of the state attributes and the values are the chains of getters of the OCM
+// object, both separated by dots. For example "aws_tags" -> "AWS.Tags". A null state attribute
+// matches an OCM field that isn't set.
+func AssertStateMatchesOCM(resource interface{}, ocmObject interface{}, mapping map[string]string) {
+	instances := DigArray(resource, "instances")
+	Expect(instances).ToNot(BeEmpty(), "resource has no instances in the state")
+	attributes := DigObject(instances[0], "attributes")
+	for attribute, field := range mapping {
+		stateValue := Dig(attributes, stateAttributePath(attribute))
+		ocmValue, err := digOCMField(ocmObject, field)
+		Expect(err).ToNot(HaveOccurred())
+		if stateValue == nil {
+			Expect(ocmValue.IsZero()).To(BeTrue(),
+				"state attribute '%s' is null but OCM field '%s' is '%v'", attribute, field, ocmValue)
+			continue
+		}
+		Expect(fmt.Sprint(stateValue)).To(Equal(fmt.Sprint(ocmValue)),
+			"state attribute '%s' doesn't match OCM field '%s'", attribute, field)
+	}
+}
+
+// stateAttributePath splits a dot separated attribute path into the keys expected by Dig.
+// Numeric segments are list indexes.
+func stateAttributePath(attribute string) []interface{} {
+	var keys []interface{}
+	for _, segment := range strings.Split(attribute, ".") {
+		if index, err := strconv.Atoi(segment); err == nil {
+			keys = append(keys, index)
+		} else {
+			keys = append(keys, segment)
+		}
+	}
+	return keys
+}
+
+// digOCMField calls the dot separated chain of getters on the given OCM object and returns the
+// resulting value.
+func digOCMField(object interface{}, field string) (reflect.Value, error) {
+	value := reflect.ValueOf(object)
+	for _, getter := range strings.Split(field, ".") {
+		method := value.MethodByName(getter)
+		if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+			return value, fmt.Errorf("'%s' isn't a getter of '%s'", getter, value.Type())
+		}
+		value = method.Call(nil)[0]
+	}
+	return value, nil
+}
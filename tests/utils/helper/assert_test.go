@@ -0,0 +1,78 @@
+package helper
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("AssertStateMatchesOCM", func() {
+	It("matches the state of a machine pool with the OCM object", func() {
+		var resource interface{}
+		err := json.Unmarshal([]byte(`{
+		  "type": "rhcs_machine_pool",
+		  "name": "mp",
+		  "instances": [
+		    {
+		      "attributes": {
+		        "name": "my-pool",
+		        "machine_type": "r5.xlarge",
+		        "replicas": null,
+		        "autoscaling_enabled": true,
+		        "min_replicas": 3,
+		        "max_replicas": 6,
+		        "labels": {
+		          "role": "worker"
+		        },
+		        "availability_zones": ["us-east-1a", "us-east-1b"]
+		      }
+		    }
+		  ]
+		}`), &resource)
+		Expect(err).ToNot(HaveOccurred())
+		machinePool, err := cmv1.NewMachinePool().
+			ID("my-pool").
+			InstanceType("r5.xlarge").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(3).MaxReplicas(6)).
+			Labels(map[string]string{"role": "worker"}).
+			AvailabilityZones("us-east-1a", "us-east-1b").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		AssertStateMatchesOCM(resource, machinePool, map[string]string{
+			"name":               "ID",
+			"machine_type":       "InstanceType",
+			"replicas":           "Replicas",
+			"min_replicas":       "Autoscaling.MinReplicas",
+			"max_replicas":       "Autoscaling.MaxReplicas",
+			"labels":             "Labels",
+			"availability_zones": "AvailabilityZones",
+		})
+	})
+
+	It("fails if an attribute doesn't match", func() {
+		var resource interface{}
+		err := json.Unmarshal([]byte(`{
+		  "instances": [
+		    {
+		      "attributes": {
+		        "machine_type": "r5.xlarge"
+		      }
+		    }
+		  ]
+		}`), &resource)
+		Expect(err).ToNot(HaveOccurred())
+		machinePool, err := cmv1.NewMachinePool().InstanceType("m5.xlarge").Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		failures := InterceptGomegaFailures(func() {
+			AssertStateMatchesOCM(resource, machinePool, map[string]string{
+				"machine_type": "InstanceType",
+			})
+		})
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0]).To(ContainSubstring("state attribute 'machine_type' doesn't match OCM field 'InstanceType'"))
+	})
+})
@@ -1,5 +1,7 @@
 package helper
 
+import "strings"
+
 type m = map[string]string
 
 // combine two strings maps to one,
@@ -22,3 +24,26 @@ func CopyStringMap(originalMap m) m {
 	}
 	return newMap
 }
+
+// NormalizeLabels returns a copy of the given labels without the ignored keys, so that the labels
+// read from OCM can be compared with the ones given to Terraform. An ignored key that ends with a
+// slash removes all the labels with that prefix, for example the system labels added by OCM. The
+// result is never nil, so an empty set of labels is equal to a missing one.
+func NormalizeLabels(labels m, ignoreKeys ...string) m {
+	normalized := make(m)
+	for key, value := range labels {
+		if !isIgnoredLabel(key, ignoreKeys) {
+			normalized[key] = value
+		}
+	}
+	return normalized
+}
+
+func isIgnoredLabel(key string, ignoreKeys []string) bool {
+	for _, ignoreKey := range ignoreKeys {
+		if key == ignoreKey || (strings.HasSuffix(ignoreKey, "/") && strings.HasPrefix(key, ignoreKey)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package helper
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NormalizeLabels", func() {
+	It("returns the same labels when no key is ignored", func() {
+		labels := map[string]string{"l1": "v1", "l2": "v2"}
+		Expect(NormalizeLabels(labels)).To(Equal(labels))
+	})
+
+	It("removes the ignored keys", func() {
+		labels := map[string]string{"l1": "v1", "l2": "v2", "l3": "v3"}
+		Expect(NormalizeLabels(labels, "l2", "l3")).To(Equal(map[string]string{"l1": "v1"}))
+	})
+
+	It("removes the keys with an ignored prefix", func() {
+		labels := map[string]string{
+			"l1":                              "v1",
+			"node-role.kubernetes.io/worker":  "",
+			"node-role.kubernetes.io/infra":   "",
+			"node-role.kubernetes.io.example": "v2",
+		}
+		Expect(NormalizeLabels(labels, "node-role.kubernetes.io/")).To(Equal(map[string]string{
+			"l1":                              "v1",
+			"node-role.kubernetes.io.example": "v2",
+		}))
+	})
+
+	It("doesn't modify the given labels", func() {
+		labels := map[string]string{"l1": "v1", "l2": "v2"}
+		NormalizeLabels(labels, "l2")
+		Expect(labels).To(HaveLen(2))
+	})
+
+	It("returns an empty map for missing labels", func() {
+		Expect(NormalizeLabels(nil, "l1")).To(BeEmpty())
+		Expect(NormalizeLabels(nil)).To(Equal(map[string]string{}))
+	})
+})
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+// The CIDRs are generated from the 10.0.0.0/8 private range
+var cidrBaseIP = net.IPv4(10, 0, 0, 0).To4()
+
+const cidrBasePrefix = 8
+
+// GenerateNonOverlappingCIDRs returns count distinct and contiguous CIDR blocks of the given
+// prefix length. It returns nil if the blocks don't fit in the 10.0.0.0/8 range
+func GenerateNonOverlappingCIDRs(count int, prefix int) []string {
+	if count <= 0 || prefix < cidrBasePrefix || prefix > 32 {
+		return nil
+	}
+	available := uint64(1) << uint(prefix-cidrBasePrefix)
+	if uint64(count) > available {
+		return nil
+	}
+
+	blockSize := uint32(1) << uint(32-prefix)
+	base := binary.BigEndian.Uint32(cidrBaseIP)
+	cidrs := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, base+uint32(i)*blockSize)
+		cidrs = append(cidrs, fmt.Sprintf("%s/%d", ip, prefix))
+	}
+	return cidrs
+}
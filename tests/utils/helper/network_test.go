@@ -0,0 +1,49 @@
+package helper
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GenerateNonOverlappingCIDRs", func() {
+	It("returns non overlapping blocks of the requested prefix", func() {
+		cidrs := GenerateNonOverlappingCIDRs(4, 16)
+		Expect(cidrs).To(HaveLen(4))
+
+		var networks []*net.IPNet
+		for _, cidr := range cidrs {
+			_, network, err := net.ParseCIDR(cidr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(network.String()).To(Equal(cidr))
+			ones, bits := network.Mask.Size()
+			Expect(ones).To(Equal(16))
+			Expect(bits).To(Equal(32))
+			networks = append(networks, network)
+		}
+		for i, a := range networks {
+			for j, b := range networks {
+				if i == j {
+					continue
+				}
+				Expect(a.Contains(b.IP)).To(BeFalse(), "%s overlaps with %s", a, b)
+			}
+		}
+	})
+
+	It("returns the blocks in order from the private range", func() {
+		Expect(GenerateNonOverlappingCIDRs(3, 24)).To(Equal([]string{
+			"10.0.0.0/24",
+			"10.0.1.0/24",
+			"10.0.2.0/24",
+		}))
+	})
+
+	It("returns nil when the blocks don't fit", func() {
+		Expect(GenerateNonOverlappingCIDRs(3, 9)).To(BeNil())
+		Expect(GenerateNonOverlappingCIDRs(1, 7)).To(BeNil())
+		Expect(GenerateNonOverlappingCIDRs(1, 33)).To(BeNil())
+		Expect(GenerateNonOverlappingCIDRs(0, 24)).To(BeNil())
+	})
+})
@@ -1,6 +1,15 @@
 package helper
 
-import "regexp"
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver"
+)
+
+const versionIDPrefix = "openshift-v"
+
+var channelGroupSuffixes = []string{"-stable", "-fast", "-candidate", "-nightly", "-eus"}
 
 func GetMajorVersion(rawVersion string) string {
 	versionRegex := regexp.MustCompile(`^[0-9]+\.[0-9]+`)
@@ -11,3 +20,29 @@ func GetMajorVersion(rawVersion string) string {
 	}
 	return vResult
 }
+
+// CompareVersions compares two OCP versions given either as raw ids like `4.14.1` or as version
+// ids like `openshift-v4.14.1` or `openshift-v4.14.1-fast`. It returns -1, 0 or 1 when a is lower
+// than, equal to or greater than b. Versions that aren't valid semver are compared as strings.
+func CompareVersions(a, b string) int {
+	versionA, errA := semver.NewVersion(rawVersion(a))
+	versionB, errB := semver.NewVersion(rawVersion(b))
+	if errA != nil || errB != nil {
+		return strings.Compare(rawVersion(a), rawVersion(b))
+	}
+	return versionA.Compare(versionB)
+}
+
+// rawVersion removes the `openshift-v` prefix and the channel group suffix from a version id.
+func rawVersion(version string) string {
+	if !strings.HasPrefix(version, versionIDPrefix) {
+		return version
+	}
+	version = strings.TrimPrefix(version, versionIDPrefix)
+	for _, suffix := range channelGroupSuffixes {
+		if strings.HasSuffix(version, suffix) {
+			return strings.TrimSuffix(version, suffix)
+		}
+	}
+	return version
+}
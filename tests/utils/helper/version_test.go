@@ -0,0 +1,26 @@
+package helper
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CompareVersions", func() {
+	DescribeTable("compares versions",
+		func(a, b string, expected int) {
+			Expect(CompareVersions(a, b)).To(Equal(expected))
+		},
+		Entry("equal raw ids", "4.14.1", "4.14.1", 0),
+		Entry("lower patch", "4.14.1", "4.14.2", -1),
+		Entry("greater minor", "4.15.0", "4.14.10", 1),
+		Entry("numeric rather than lexicographic", "4.14.9", "4.14.10", -1),
+		Entry("raw id and version id", "4.14.1", "openshift-v4.14.1", 0),
+		Entry("version ids", "openshift-v4.14.2", "openshift-v4.14.1", 1),
+		Entry("channel suffixed version id", "openshift-v4.14.1-fast", "4.14.1", 0),
+		Entry("channel suffixed version ids", "openshift-v4.14.1-candidate", "openshift-v4.14.2-fast", -1),
+		Entry("pre-release lower than release", "4.14.0-rc.1", "4.14.0", -1),
+		Entry("pre-releases", "4.14.0-rc.2", "4.14.0-rc.1", 1),
+		Entry("channel suffixed pre-release", "openshift-v4.14.0-rc.1-candidate", "4.14.0-rc.1", 0),
+		Entry("pre-release greater than previous release", "4.15.0-ec.1", "4.14.10", 1),
+	)
+})
@@ -1,14 +1,17 @@
 package openshift
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
 	client "github.com/openshift-online/ocm-sdk-go"
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/cms"
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/config"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/constants"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/helper"
 
@@ -19,6 +22,7 @@ type OcAttributes struct {
 	Server          string
 	Username        string
 	Password        string
+	Token           string
 	ClusterID       string
 	AdditionalFlags []string
 	Timeout         time.Duration
@@ -34,12 +38,30 @@ type Pod struct {
 }
 
 func GenerateOCLoginCMD(server string, username string, password string, clusterid string, additioanlFlags ...string) string {
-	cmd := fmt.Sprintf("oc login %s --username %s --password %s",
-		server, username, password)
-	if len(additioanlFlags) != 0 {
-		cmd = cmd + " " + strings.Join(additioanlFlags, " ")
+	args := BuildOcLoginArgs(OcAttributes{
+		Server:          server,
+		Username:        username,
+		Password:        password,
+		ClusterID:       clusterid,
+		AdditionalFlags: additioanlFlags,
+	})
+	return "oc " + strings.Join(args, " ")
+}
+
+// BuildOcLoginArgs returns the arguments of the `oc login` command for the given attributes.
+// The token is used instead of the username and password when set.
+// Additional flags given with their value, like `--kubeconfig <path>`, are split in separate arguments.
+func BuildOcLoginArgs(attrs OcAttributes) []string {
+	args := []string{"login", attrs.Server}
+	if attrs.Token != "" {
+		args = append(args, fmt.Sprintf("--token=%s", attrs.Token))
+	} else {
+		args = append(args, "--username", attrs.Username, "--password", attrs.Password)
+	}
+	for _, flag := range attrs.AdditionalFlags {
+		args = append(args, strings.Fields(flag)...)
 	}
-	return cmd
+	return args
 }
 
 func RetryCMDRun(cmd string, timeout time.Duration) (string, error) {
@@ -60,18 +82,115 @@ func RetryCMDRun(cmd string, timeout time.Duration) (string, error) {
 	return "", fmt.Errorf("timeout %d mins for command run %s with error: %s", timeout, cmd, err.Error())
 }
 
+// validateOcLoginAuth checks a single authentication mode is set in the attributes
+func validateOcLoginAuth(attrs OcAttributes) error {
+	if attrs.Token != "" && (attrs.Username != "" || attrs.Password != "") {
+		return fmt.Errorf("token and username/password can't be both used to login to cluster %s", attrs.ClusterID)
+	}
+	return nil
+}
+
 func OcLogin(ocLoginAtter OcAttributes) (string, error) {
-	cmd := GenerateOCLoginCMD(ocLoginAtter.Server,
-		ocLoginAtter.Username,
-		ocLoginAtter.Password,
-		ocLoginAtter.ClusterID,
-		ocLoginAtter.AdditionalFlags...)
+	if err := validateOcLoginAuth(ocLoginAtter); err != nil {
+		return "", err
+	}
+	cmd := "oc " + strings.Join(BuildOcLoginArgs(ocLoginAtter), " ")
 
 	output, err := RetryCMDRun(cmd, ocLoginAtter.Timeout)
 	return output, err
 
 }
 
+// Taint is a taint of a node, as reported by `oc get nodes`.
+type Taint struct {
+	Node   string `json:"-"`
+	Key    string `json:"key,omitempty"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect,omitempty"`
+}
+
+// runOcCommand runs the oc commands. It is a variable so that tests can replace it
+var runOcCommand = helper.RunCMD
+
+// shellQuote quotes the given value so that the shell that runs the oc commands passes it as a
+// single argument, even if it contains spaces or characters like `(` and `!`.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// BuildOcGetNodesArgs returns the arguments of the `oc get nodes` command listing the nodes with
+// the given label selector as JSON. The selector is quoted for the shell, so that set-based
+// selectors like `env in (a,b)` can be used. The server and the token are used when set, and the
+// additional flags are split like for the login, so that the kubeconfig of a login can be given.
+func BuildOcGetNodesArgs(attrs OcAttributes, labelSelector string) []string {
+	args := []string{"get", "nodes", "-o", "json"}
+	if labelSelector != "" {
+		args = append(args, "-l", shellQuote(labelSelector))
+	}
+	if attrs.Server != "" {
+		args = append(args, fmt.Sprintf("--server=%s", attrs.Server))
+	}
+	if attrs.Token != "" {
+		args = append(args, fmt.Sprintf("--token=%s", attrs.Token))
+	}
+	for _, flag := range attrs.AdditionalFlags {
+		args = append(args, strings.Fields(flag)...)
+	}
+	return args
+}
+
+// GetNodeTaints returns the taints of the nodes with the given label selector, for example
+// `node-role.kubernetes.io/worker`, in the order of the nodes. The node of each taint is set, so
+// that the taints of a machine pool can be checked on every one of its nodes.
+func GetNodeTaints(attrs OcAttributes, labelSelector string) ([]Taint, error) {
+	cmd := "oc " + strings.Join(BuildOcGetNodesArgs(attrs, labelSelector), " ")
+	stdout, stderr, err := runOcCommand(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the nodes of cluster %s: %v: %s", attrs.ClusterID, err, stderr)
+	}
+	var nodes struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Spec struct {
+				Taints []Taint `json:"taints"`
+			} `json:"spec"`
+		} `json:"items"`
+	}
+	err = json.Unmarshal([]byte(stdout), &nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the nodes of cluster %s: %v", attrs.ClusterID, err)
+	}
+	taints := []Taint{}
+	for _, node := range nodes.Items {
+		for _, taint := range node.Spec.Taints {
+			taint.Node = node.Metadata.Name
+			taints = append(taints, taint)
+		}
+	}
+	return taints, nil
+}
+
+// kubeconfigParentDir returns the dir where the temporary kubeconfig dirs are created. It is a
+// variable so that tests can replace it
+var kubeconfigParentDir = config.GetKubeConfigDir
+
+// NewTempKubeconfigDir creates a new dir for the kubeconfigs written by the oc logins of a test,
+// and returns a function that removes it, so that the sessions don't accumulate between tests
+func NewTempKubeconfigDir() (dir string, cleanup func(), err error) {
+	dir, err = os.MkdirTemp(kubeconfigParentDir(), "session-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create the kubeconfig dir: %v", err)
+	}
+	cleanup = func() {
+		if err := os.RemoveAll(dir); err != nil {
+			Logger.Warnf("Failed to remove the kubeconfig dir %s: %v", dir, err)
+		}
+	}
+	return dir, cleanup, nil
+}
+
 func WaitForOperatorsToBeReady(connection *client.Connection, clusterID string, timeout int) error {
 	// WaitClusterOperatorsToReadyStatus will wait for cluster operators ready
 	timeoutMin := time.Duration(timeout)
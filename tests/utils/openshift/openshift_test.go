@@ -0,0 +1,254 @@
+package openshift
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/config"
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/helper"
+)
+
+var _ = Describe("oc login", func() {
+	Context("BuildOcLoginArgs", func() {
+		It("sets the server and the credentials", func() {
+			args := BuildOcLoginArgs(OcAttributes{
+				Server:   "https://api.example.com:6443",
+				Username: "my-user",
+				Password: "my-password",
+			})
+			Expect(args).To(Equal([]string{
+				"login", "https://api.example.com:6443",
+				"--username", "my-user",
+				"--password", "my-password",
+			}))
+		})
+
+		It("appends the kubeconfig and insecure flags", func() {
+			args := BuildOcLoginArgs(OcAttributes{
+				Server:   "https://api.example.com:6443",
+				Username: "my-user",
+				Password: "my-password",
+				AdditionalFlags: []string{
+					"--insecure-skip-tls-verify",
+					"--kubeconfig /tmp/kubeconfig",
+				},
+			})
+			Expect(args).To(Equal([]string{
+				"login", "https://api.example.com:6443",
+				"--username", "my-user",
+				"--password", "my-password",
+				"--insecure-skip-tls-verify",
+				"--kubeconfig", "/tmp/kubeconfig",
+			}))
+		})
+
+		It("is used to generate the login command", func() {
+			cmd := GenerateOCLoginCMD("https://api.example.com:6443", "my-user", "my-password", "123",
+				"--kubeconfig /tmp/kubeconfig")
+			Expect(cmd).To(Equal("oc login https://api.example.com:6443 --username my-user --password my-password --kubeconfig /tmp/kubeconfig"))
+		})
+	})
+
+	Context("token login", func() {
+		It("uses the token instead of the credentials", func() {
+			args := BuildOcLoginArgs(OcAttributes{
+				Server:          "https://api.example.com:6443",
+				Token:           "sha256~my-token",
+				AdditionalFlags: []string{"--insecure-skip-tls-verify"},
+			})
+			Expect(args).To(Equal([]string{
+				"login", "https://api.example.com:6443",
+				"--token=sha256~my-token",
+				"--insecure-skip-tls-verify",
+			}))
+		})
+
+		It("fails when both the token and the credentials are set", func() {
+			_, err := OcLogin(OcAttributes{
+				Server:    "https://api.example.com:6443",
+				Username:  "my-user",
+				Password:  "my-password",
+				Token:     "sha256~my-token",
+				ClusterID: "123",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("token and username/password can't be both used"))
+		})
+
+		It("accepts a single authentication mode", func() {
+			Expect(validateOcLoginAuth(OcAttributes{Token: "sha256~my-token"})).To(Succeed())
+			Expect(validateOcLoginAuth(OcAttributes{Username: "my-user", Password: "my-password"})).To(Succeed())
+		})
+	})
+})
+
+var _ = Describe("Temporary kubeconfig dir", func() {
+	var parent string
+
+	BeforeEach(func() {
+		parent = GinkgoT().TempDir()
+		kubeconfigParentDir = func() string { return parent }
+		DeferCleanup(func() {
+			kubeconfigParentDir = config.GetKubeConfigDir
+		})
+	})
+
+	It("creates the dir and removes it on cleanup", func() {
+		dir, cleanup, err := NewTempKubeconfigDir()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Dir(dir)).To(Equal(parent))
+		Expect(dir).To(BeADirectory())
+
+		// Write a kubeconfig like the oc logins do:
+		Expect(os.WriteFile(filepath.Join(dir, "123.my-user"), []byte("apiVersion: v1"), 0600)).To(Succeed())
+
+		cleanup()
+		Expect(dir).ToNot(BeAnExistingFile())
+	})
+
+	It("creates a different dir for each session", func() {
+		first, cleanupFirst, err := NewTempKubeconfigDir()
+		Expect(err).ToNot(HaveOccurred())
+		defer cleanupFirst()
+		second, cleanupSecond, err := NewTempKubeconfigDir()
+		Expect(err).ToNot(HaveOccurred())
+		defer cleanupSecond()
+		Expect(first).ToNot(Equal(second))
+	})
+})
+
+var _ = Describe("Node taints", func() {
+	var cmds []string
+
+	fakeOc := func(stdout string, stderr string, err error) {
+		runOcCommand = func(cmd string) (string, string, error) {
+			cmds = append(cmds, cmd)
+			return stdout, stderr, err
+		}
+	}
+
+	BeforeEach(func() {
+		cmds = nil
+		DeferCleanup(func() {
+			runOcCommand = helper.RunCMD
+		})
+	})
+
+	It("parses the taints of the selected nodes", func() {
+		fakeOc(`{
+		  "apiVersion": "v1",
+		  "kind": "List",
+		  "items": [
+		    {
+		      "kind": "Node",
+		      "metadata": {
+		        "name": "worker-1",
+		        "labels": {
+		          "node-role.kubernetes.io/worker": ""
+		        }
+		      },
+		      "spec": {
+		        "taints": [
+		          {
+		            "key": "dedicated",
+		            "value": "db",
+		            "effect": "NoSchedule"
+		          },
+		          {
+		            "key": "node.kubernetes.io/unreachable",
+		            "effect": "NoExecute",
+		            "timeAdded": "2024-01-01T00:00:00Z"
+		          }
+		        ]
+		      }
+		    },
+		    {
+		      "kind": "Node",
+		      "metadata": {
+		        "name": "worker-2"
+		      },
+		      "spec": {}
+		    },
+		    {
+		      "kind": "Node",
+		      "metadata": {
+		        "name": "worker-3"
+		      },
+		      "spec": {
+		        "taints": [
+		          {
+		            "key": "dedicated",
+		            "value": "db",
+		            "effect": "NoSchedule"
+		          }
+		        ]
+		      }
+		    }
+		  ]
+		}`, "", nil)
+
+		taints, err := GetNodeTaints(OcAttributes{
+			ClusterID:       "123",
+			AdditionalFlags: []string{"--kubeconfig /tmp/kubeconfig"},
+		}, "node-role.kubernetes.io/worker")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(taints).To(Equal([]Taint{
+			{Node: "worker-1", Key: "dedicated", Value: "db", Effect: "NoSchedule"},
+			{Node: "worker-1", Key: "node.kubernetes.io/unreachable", Effect: "NoExecute"},
+			{Node: "worker-3", Key: "dedicated", Value: "db", Effect: "NoSchedule"},
+		}))
+		Expect(cmds).To(Equal([]string{
+			"oc get nodes -o json -l 'node-role.kubernetes.io/worker' --kubeconfig /tmp/kubeconfig",
+		}))
+	})
+
+	It("returns no taints when the nodes have none", func() {
+		fakeOc(`{"kind": "List", "items": []}`, "", nil)
+		taints, err := GetNodeTaints(OcAttributes{ClusterID: "123"}, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(taints).To(BeEmpty())
+		Expect(cmds).To(Equal([]string{"oc get nodes -o json"}))
+	})
+
+	It("uses the server and the token when set", func() {
+		args := BuildOcGetNodesArgs(OcAttributes{
+			Server: "https://api.example.com:6443",
+			Token:  "sha256~my-token",
+		}, "app=db")
+		Expect(args).To(Equal([]string{
+			"get", "nodes", "-o", "json",
+			"-l", "'app=db'",
+			"--server=https://api.example.com:6443",
+			"--token=sha256~my-token",
+		}))
+	})
+
+	It("quotes set-based selectors for the shell", func() {
+		fakeOc(`{"kind": "List", "items": []}`, "", nil)
+		_, err := GetNodeTaints(OcAttributes{ClusterID: "123"}, "env in (a,b),!key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cmds).To(Equal([]string{"oc get nodes -o json -l 'env in (a,b),!key'"}))
+	})
+
+	It("escapes the quotes of the selector", func() {
+		args := BuildOcGetNodesArgs(OcAttributes{}, "app=it's")
+		Expect(args).To(Equal([]string{"get", "nodes", "-o", "json", "-l", `'app=it'\''s'`}))
+	})
+
+	It("fails when oc fails", func() {
+		fakeOc("", "error: You must be logged in to the server (Unauthorized)", errors.New("exit status 1"))
+		_, err := GetNodeTaints(OcAttributes{ClusterID: "123"}, "")
+		Expect(err).To(MatchError(ContainSubstring("failed to get the nodes of cluster 123")))
+		Expect(err).To(MatchError(ContainSubstring("Unauthorized")))
+	})
+
+	It("fails when the output isn't JSON", func() {
+		fakeOc("No resources found", "", nil)
+		_, err := GetNodeTaints(OcAttributes{ClusterID: "123"}, "")
+		Expect(err).To(MatchError(ContainSubstring("failed to parse the nodes of cluster 123")))
+	})
+})
@@ -3,6 +3,7 @@ package profilehandler
 import (
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/config"
 	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/constants"
@@ -27,7 +28,43 @@ func loadProfileYamlFile(profileName string) (*Profile, error) {
 	}
 
 	setDefaultOrEnvProfileValues(profile)
-	return profile, err
+	err = validateProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// validateProfile checks the fields needed by the tests are set and valid, so that a malformed
+// profile fails when loaded instead of deep in the tests
+func validateProfile(profile *Profile) error {
+	var invalidFields []string
+	switch profile.ClusterType {
+	case "":
+		invalidFields = append(invalidFields, "cluster_type is missing")
+	case constants.ROSA_CLASSIC.String(), constants.ROSA_HCP.String():
+	default:
+		invalidFields = append(invalidFields, fmt.Sprintf("cluster_type '%s' is unknown", profile.ClusterType))
+	}
+	if profile.Region == "" {
+		invalidFields = append(invalidFields, "region is missing")
+	}
+	if profile.CloudProvider != constants.AWS {
+		invalidFields = append(invalidFields, fmt.Sprintf("cloud_provider '%s' is not supported", profile.CloudProvider))
+	}
+	if profile.ComputeReplicas < 0 {
+		invalidFields = append(invalidFields, "compute_replicas can't be negative")
+	}
+	if profile.WorkerDiskSize < 0 {
+		invalidFields = append(invalidFields, "worker_disk_size can't be negative")
+	}
+	if profile.AdditionalSGNumber < 0 {
+		invalidFields = append(invalidFields, "additional_sg_number can't be negative")
+	}
+	if len(invalidFields) > 0 {
+		return fmt.Errorf("invalid profile %s: %s", profile.Name, strings.Join(invalidFields, ", "))
+	}
+	return nil
 }
 
 func setDefaultOrEnvProfileValues(profile *Profile) {
@@ -69,6 +106,9 @@ func setDefaultOrEnvProfileValues(profile *Profile) {
 	if profile.ChannelGroup == "" {
 		profile.ChannelGroup = constants.VersionStableChannel
 	}
+	if profile.CloudProvider == "" {
+		profile.CloudProvider = constants.AWS
+	}
 }
 
 func LoadProfileYamlFileByENV() (*Profile, error) {
@@ -0,0 +1,60 @@
+package profilehandler
+
+import (
+	"os"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/tests/utils/config"
+)
+
+var _ = Describe("Profile loading", func() {
+	BeforeEach(func() {
+		profilesDir := GinkgoT().TempDir()
+		err := os.WriteFile(path.Join(profilesDir, "test_profiles.yml"), []byte(`
+profiles:
+- as: valid
+  cluster:
+    cluster_type: rosa-hcp
+    region: "us-west-2"
+- as: missing-fields
+  cluster:
+    multi_az: true
+- as: invalid-fields
+  cluster:
+    cluster_type: rosa-unknown
+    cloud_provider: "gcp"
+    region: "us-west-2"
+    compute_replicas: -1
+`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		GinkgoT().Setenv(config.EnvClusterProfilesDir, profilesDir)
+	})
+
+	It("loads a valid profile", func() {
+		profile, err := loadProfileYamlFile("valid")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(profile.ClusterType).To(Equal("rosa-hcp"))
+		Expect(profile.Region).To(Equal("us-west-2"))
+		Expect(profile.CloudProvider).To(Equal("aws"))
+	})
+
+	It("enumerates the missing fields", func() {
+		_, err := loadProfileYamlFile("missing-fields")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid profile missing-fields"))
+		Expect(err.Error()).To(ContainSubstring("cluster_type is missing"))
+		Expect(err.Error()).To(ContainSubstring("region is missing"))
+	})
+
+	It("enumerates the invalid fields", func() {
+		_, err := loadProfileYamlFile("invalid-fields")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("cluster_type 'rosa-unknown' is unknown"))
+		Expect(err.Error()).To(ContainSubstring("cloud_provider 'gcp' is not supported"))
+		Expect(err.Error()).To(ContainSubstring("compute_replicas can't be negative"))
+		Expect(err.Error()).ToNot(ContainSubstring("region"))
+	})
+})
@@ -61,6 +61,7 @@ type ProfileServices interface {
 	GetClusterWaiterService() (exec.ClusterWaiterService, error)
 	GetDnsDomainService() (exec.DnsDomainService, error)
 	GetIDPService(idpType constants.IDPType) (exec.IDPService, error)
+	GetNamedIDPService(idpType constants.IDPType, name string) (exec.IDPService, error)
 	GetIngressService() (exec.IngressService, error)
 	GetImportService() (exec.ImportService, error)
 	GetKubeletConfigService() (exec.KubeletConfigService, error)
@@ -1205,6 +1206,12 @@ func (ctx *profileContext) GetIDPService(idpType constants.IDPType) (exec.IDPSer
 	return exec.NewIDPService(ctx.GetTFWorkspace(), ctx.GetClusterType(), idpType)
 }
 
+// GetNamedIDPService returns an idp service using its own terraform workspace, so that several
+// idps of the same type can be managed at the same time
+func (ctx *profileContext) GetNamedIDPService(idpType constants.IDPType, name string) (exec.IDPService, error) {
+	return exec.NewIDPService(fmt.Sprintf("%s-%s", ctx.GetTFWorkspace(), name), ctx.GetClusterType(), idpType)
+}
+
 func (ctx *profileContext) GetIngressService() (exec.IngressService, error) {
 	return exec.NewIngressService(ctx.GetTFWorkspace(), ctx.GetClusterType())
 }
@@ -0,0 +1,13 @@
+package profilehandler
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestProfileHandler(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Profile Handler Suite")
+}